@@ -0,0 +1,98 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Capability identifies an optional server feature.
+type Capability string
+
+// Capability values.
+const (
+	CapabilityDraftPullRequests       Capability = "draft_pull_requests"
+	CapabilityMergeQueue              Capability = "merge_queue"
+	CapabilityMultiLineReviewComments Capability = "multi_line_review_comments"
+	CapabilityHookDeliveries          Capability = "hook_deliveries"
+	CapabilityTokenInfo               Capability = "token_info"
+)
+
+// Supports reports whether the server supports the
+// capability. The capability is supported if the driver
+// lists the capability, and the server Version is greater
+// than or equal to the minimum version listed. If the
+// server Version is unknown, the latest version is assumed.
+func (c *Client) Supports(capability Capability) bool {
+	min, ok := c.Capabilities[capability]
+	if !ok {
+		return false
+	}
+	return c.VersionAtLeast(min)
+}
+
+// CopyCapabilities returns a copy of the capabilities, so
+// that changes to the capabilities of one client do not
+// affect other clients.
+func CopyCapabilities(capabilities map[Capability]string) map[Capability]string {
+	copied := make(map[Capability]string, len(capabilities))
+	for k, v := range capabilities {
+		copied[k] = v
+	}
+	return copied
+}
+
+// VersionAtLeast reports whether the server Version is
+// greater than or equal to the minimum version. If the
+// minimum version or the server Version is unknown, the
+// latest version is assumed.
+func (c *Client) VersionAtLeast(min string) bool {
+	if min == "" || c.Version == "" {
+		return true
+	}
+	return compareVersion(c.Version, min) >= 0
+}
+
+// compareVersion compares the numeric components of the
+// dotted version strings, ignoring any leading v and any
+// pre-release or build suffix. It returns -1, 0 or +1.
+func compareVersion(a, b string) int {
+	x, y := parseVersion(a), parseVersion(b)
+	for i := 0; i < len(x) || i < len(y); i++ {
+		var m, n int
+		if i < len(x) {
+			m = x[i]
+		}
+		if i < len(y) {
+			n = y[i]
+		}
+		switch {
+		case m < n:
+			return -1
+		case m > n:
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion returns the numeric components of the
+// version string.
+func parseVersion(s string) []int {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(s, "-+ "); i != -1 {
+		s = s[:i]
+	}
+	var parts []int
+	for _, p := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			break
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
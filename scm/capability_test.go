@@ -0,0 +1,84 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import "testing"
+
+func TestSupports(t *testing.T) {
+	client := &Client{
+		Capabilities: map[Capability]string{
+			CapabilityDraftPullRequests:       "13.2",
+			CapabilityMultiLineReviewComments: "",
+		},
+	}
+	tests := []struct {
+		version    string
+		capability Capability
+		want       bool
+	}{
+		{"", CapabilityDraftPullRequests, true},
+		{"13.2.0", CapabilityDraftPullRequests, true},
+		{"16.8.1-ee", CapabilityDraftPullRequests, true},
+		{"v13.10", CapabilityDraftPullRequests, true},
+		{"13.1.9", CapabilityDraftPullRequests, false},
+		{"9.5", CapabilityDraftPullRequests, false},
+		{"9.5", CapabilityMultiLineReviewComments, true},
+		{"", CapabilityMergeQueue, false},
+	}
+	for _, test := range tests {
+		client.Version = test.version
+		if got := client.Supports(test.capability); got != test.want {
+			t.Errorf("Want %s supported %v for version %q, got %v", test.capability, test.want, test.version, got)
+		}
+	}
+}
+
+func TestCopyCapabilities(t *testing.T) {
+	from := map[Capability]string{
+		CapabilityDraftPullRequests: "13.2",
+	}
+	copied := CopyCapabilities(from)
+	delete(copied, CapabilityDraftPullRequests)
+	if _, ok := from[CapabilityDraftPullRequests]; !ok {
+		t.Errorf("Expect the capabilities not modified by changes to the copy")
+	}
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version, min string
+		want         bool
+	}{
+		{"", "1.17", true},
+		{"1.17.0", "", true},
+		{"1.17.0", "1.17", true},
+		{"1.21.11+dev-12-g6e6d8b3", "1.17", true},
+		{"1.16.9", "1.17", false},
+	}
+	for _, test := range tests {
+		client := &Client{Version: test.version}
+		if got := client.VersionAtLeast(test.min); got != test.want {
+			t.Errorf("Want version %q at least %q %v, got %v", test.version, test.min, test.want, got)
+		}
+	}
+}
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.21.4", "1.10", 1},
+		{"1.9", "1.10", -1},
+		{"3.11.0", "3.11", 0},
+		{"7.0.0+gitea-1.22.0", "1.10", 1},
+		{"8.9.2", "8.18", -1},
+	}
+	for _, test := range tests {
+		if got := compareVersion(test.a, test.b); got != test.want {
+			t.Errorf("Want compare %s to %s = %d, got %d", test.a, test.b, test.want, got)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -131,11 +132,30 @@ type (
 		Users         UserService
 		Webhooks      WebhookService
 
+		// Version is the server version, used to determine
+		// the capabilities of self-hosted servers. If empty,
+		// the latest version is assumed.
+		Version string
+
+		// Capabilities lists the capabilities supported by
+		// the driver, and the minimum server version that
+		// supports each capability.
+		Capabilities map[Capability]string
+
 		// DumpResponse optionally specifies a function to
 		// dump the the response body for debugging purposes.
 		// This can be set to httputil.DumpResponse.
 		DumpResponse func(*http.Response, bool) ([]byte, error)
 
+		// Observer optionally specifies an observer that is
+		// notified before and after each request, for
+		// logging, metrics and tracing purposes.
+		Observer Observer
+
+		// Limiter optionally specifies a limiter that limits
+		// the rate and concurrency of requests.
+		Limiter Limiter
+
 		// snapshot of the request rate limit.
 		rate Rate
 	}
@@ -180,18 +200,55 @@ func (c *Client) Do(ctx context.Context, in *Request) (*Response, error) {
 		req.URL.Opaque = strings.Split(req.URL.RawPath, "?")[0]
 	}
 
-	req = req.WithContext(ctx)
 	if in.Header != nil {
 		req.Header = in.Header
 	}
 
+	// waits for the limiter before the request is sent.
+	var wait time.Duration
+	var done func(*Response)
+	if c.Limiter != nil {
+		start := time.Now()
+		done, err = c.Limiter.Wait(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		wait = time.Since(start)
+	}
+
+	// notifies the observer before the request is sent.
+	var event *RequestEvent
+	if c.Observer != nil {
+		event = &RequestEvent{
+			Driver:   c.Driver,
+			Method:   in.Method,
+			Path:     req.URL.Path,
+			Template: pathTemplate(in.Path),
+			Header:   RedactHeader(req.Header),
+			Wait:     wait,
+		}
+		ctx = c.Observer.Before(ctx, event)
+	}
+	req = req.WithContext(ctx)
+
 	// use the default client if none provided.
 	client := c.Client
 	if client == nil {
 		client = http.DefaultClient
 	}
+	start := time.Now()
 	res, err := client.Do(req)
 	if err != nil {
+		if done != nil {
+			done(nil)
+		}
+		if event != nil {
+			c.Observer.After(ctx, &ResponseEvent{
+				Request: *event,
+				Latency: time.Since(start),
+				Err:     err,
+			})
+		}
 		return nil, err
 	}
 
@@ -201,7 +258,31 @@ func (c *Client) Do(ctx context.Context, in *Request) (*Response, error) {
 			_, _ = os.Stdout.Write(raw)
 		}
 	}
-	return newResponse(res), nil
+	out := newResponse(res)
+
+	// notifies the limiter and observer once the response
+	// body is closed, after the driver has parsed the
+	// response id and rate limit.
+	if done != nil || event != nil {
+		out.Body = &observedBody{
+			ReadCloser: out.Body,
+			after: func() {
+				if done != nil {
+					done(out)
+				}
+				if event != nil {
+					c.Observer.After(ctx, &ResponseEvent{
+						Request: *event,
+						Status:  out.Status,
+						ID:      out.ID,
+						Rate:    out.Rate,
+						Latency: time.Since(start),
+					})
+				}
+			},
+		}
+	}
+	return out, nil
 }
 
 // newResponse creates a new Response for the provided
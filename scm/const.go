@@ -53,6 +53,12 @@ const (
 	// merge queue
 	ActionChecksRequested
 	ActionChecksCanceled
+	// issue triage
+	ActionAssign
+	ActionUnassign
+	ActionMilestone
+	ActionDemilestone
+	ActionTransfer
 )
 
 // String returns the string representation of Action.
@@ -98,6 +104,16 @@ func (a Action) String() (s string) {
 		return "checks_requested"
 	case ActionChecksCanceled:
 		return "checks_canceled"
+	case ActionAssign:
+		return "assigned"
+	case ActionUnassign:
+		return "unassigned"
+	case ActionMilestone:
+		return "milestoned"
+	case ActionDemilestone:
+		return "demilestoned"
+	case ActionTransfer:
+		return "transferred"
 	default:
 		return
 	}
@@ -155,6 +171,16 @@ func (a *Action) UnmarshalJSON(data []byte) error {
 		*a = ActionChecksRequested
 	case "checks_canceled":
 		*a = ActionChecksCanceled
+	case "assigned":
+		*a = ActionAssign
+	case "unassigned":
+		*a = ActionUnassign
+	case "milestoned":
+		*a = ActionMilestone
+	case "demilestoned":
+		*a = ActionDemilestone
+	case "transferred":
+		*a = ActionTransfer
 	}
 	return nil
 }
@@ -368,4 +394,54 @@ func (k *ExecutionStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// ReviewState defines an enum for the state of a pull
+// request review, eg a reviewer approval.
+type ReviewState int
+
+// ReviewState values.
+const (
+	ReviewStateUnknown ReviewState = iota
+	ReviewStatePending
+	ReviewStateApproved
+	ReviewStateChangesRequested
+)
+
+// String returns the string representation of ReviewState.
+func (k ReviewState) String() string {
+	switch k {
+	case ReviewStatePending:
+		return "pending"
+	case ReviewStateApproved:
+		return "approved"
+	case ReviewStateChangesRequested:
+		return "changes_requested"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalJSON returns the JSON-encoded ReviewState.
+func (k ReviewState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// UnmarshalJSON unmarshales the JSON-encoded ReviewState.
+func (k *ReviewState) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch s {
+	case ReviewStatePending.String():
+		*k = ReviewStatePending
+	case ReviewStateApproved.String():
+		*k = ReviewStateApproved
+	case ReviewStateChangesRequested.String():
+		*k = ReviewStateChangesRequested
+	default:
+		*k = ReviewStateUnknown
+	}
+	return nil
+}
+
 const SearchTimeFormat = "2006-01-02T15:04:05Z"
@@ -37,7 +37,7 @@ func New(uri, owner, project string) (*scm.Client, error) {
 	client.BaseURL = base
 	// initialize services
 	client.Driver = scm.DriverAzure
-	client.Linker = &linker{base.String()}
+	client.Linker = &linker{base.String(), owner, project}
 	client.Contents = &contentService{client}
 	client.Git = &gitService{client}
 	client.Issues = &issueService{client}
@@ -47,6 +47,7 @@ func New(uri, owner, project string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Capabilities = scm.CopyCapabilities(capabilities)
 	return client.Client, nil
 }
 
@@ -56,6 +57,13 @@ func NewDefault(owner, project string) *scm.Client {
 	return client
 }
 
+// capabilities lists the capabilities supported by the
+// driver.
+var capabilities = map[scm.Capability]string{
+	scm.CapabilityDraftPullRequests:       "",
+	scm.CapabilityMultiLineReviewComments: "",
+}
+
 // wrapper wraps the Client to provide high level helper functions for making http requests and unmarshaling the response.
 type wrapper struct {
 	*scm.Client
@@ -73,8 +81,13 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	if in != nil {
 		buf := new(bytes.Buffer)
 		_ = json.NewEncoder(buf).Encode(in)
+		contentType := "application/json"
+		// work item updates are json patch documents.
+		if _, ok := in.(jsonPatch); ok {
+			contentType = "application/json-patch+json"
+		}
 		req.Header = map[string][]string{
-			"Content-Type": {"application/json"},
+			"Content-Type": {contentType},
 		}
 		req.Body = buf
 	}
@@ -108,6 +121,23 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 	return res, decodeErr
 }
 
+// hosted returns true if the client is configured for the
+// hosted service rather than Azure DevOps Server.
+func (c *wrapper) hosted() bool {
+	host := strings.ToLower(c.BaseURL.Hostname())
+	return host == "dev.azure.com" || strings.HasSuffix(host, ".visualstudio.com")
+}
+
+// vssps returns the address of the identity and graph
+// endpoints, which are served from a separate host by the
+// hosted service, and the server address otherwise.
+func (c *wrapper) vssps(path string) string {
+	if c.hosted() {
+		return "https://vssps.dev.azure.com/" + path
+	}
+	return path
+}
+
 // Error represents am Azure error.
 type Error struct {
 	Message string `json:"message"`
@@ -7,6 +7,9 @@ package azure
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -31,10 +34,15 @@ func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.
 }
 
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/list?view=azure-devops-rest-6.0
 	if s.client.project == "" {
 		return nil, nil, ProjectRequiredError()
 	}
-	return nil, nil, scm.ErrNotSupported
+	out, res, err := s.findRef(ctx, repo, scm.ExpandRef(name, "refs/heads/"))
+	if err != nil {
+		return nil, res, err
+	}
+	return convertBranch(out), res, nil
 }
 
 func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Commit, *scm.Response, error) {
@@ -49,7 +57,25 @@ func (s *gitService) FindCommit(ctx context.Context, repo, ref string) (*scm.Com
 }
 
 func (s *gitService) FindTag(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/refs/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	out, res, err := s.findRef(ctx, repo, scm.ExpandRef(name, "refs/tags/"))
+	if err != nil {
+		return nil, res, err
+	}
+	// the object id of an annotated tag is the tag object,
+	// and the peeled object id is the tagged commit.
+	sha := out.ObjectID
+	if out.PeeledObjectID != "" {
+		sha = out.PeeledObjectID
+	}
+	return &scm.Reference{
+		Name: scm.TrimRef(out.Name),
+		Path: out.Name,
+		Sha:  sha,
+	}, res, nil
 }
 
 func (s *gitService) ListBranches(ctx context.Context, repo string, _ scm.ListOptions) ([]*scm.Reference, *scm.Response, error) {
@@ -108,8 +134,24 @@ func (s *gitService) ListTags(ctx context.Context, repo string, opts scm.ListOpt
 	return convertTags(out.Value), res, err
 }
 
-func (s *gitService) ListChanges(ctx context.Context, repo, ref string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *gitService) ListChanges(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/commits/get-changes?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	params := url.Values{}
+	if opts.Size != 0 {
+		params.Set("top", strconv.Itoa(opts.Size))
+		if opts.Page > 1 {
+			params.Set("skip", strconv.Itoa((opts.Page-1)*opts.Size))
+		}
+	}
+	params.Set("api-version", "6.0")
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s/changes?%s",
+		s.client.owner, s.client.project, repo, ref, params.Encode())
+	out := new(commitChanges)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertCommitChangeList(out.Changes), res, err
 }
 
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
@@ -129,6 +171,29 @@ func (s *gitService) CompareChanges(ctx context.Context, repo, source, target st
 	return convertChangeList(changes), res, err
 }
 
+// findRef returns the named reference. The refs filter
+// matches the reference prefix, so the results are searched
+// for an exact match.
+func (s *gitService) findRef(ctx context.Context, repo, name string) (*branch, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("filter", strings.TrimPrefix(name, "refs/"))
+	params.Set("peelTags", "true")
+	params.Set("api-version", "6.0")
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?%s",
+		s.client.owner, s.client.project, repo, params.Encode())
+	out := new(branchList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, v := range out.Value {
+		if v.Name == name {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
 type crudBranch []struct {
 	Name        string `json:"name"`
 	OldObjectID string `json:"oldObjectId"`
@@ -141,9 +206,10 @@ type branchList struct {
 }
 
 type branch struct {
-	Name     string `json:"name"`
-	ObjectID string `json:"objectId"`
-	Creator  struct {
+	Name           string `json:"name"`
+	ObjectID       string `json:"objectId"`
+	PeeledObjectID string `json:"peeledObjectId"`
+	Creator        struct {
 		DisplayName string `json:"displayName"`
 		URL         string `json:"url"`
 		Links       struct {
@@ -197,6 +263,12 @@ type file struct {
 		Path             string `json:"path"`
 		URL              string `json:"url"`
 	} `json:"item"`
+	SourceServerItem string `json:"sourceServerItem"`
+}
+
+type commitChanges struct {
+	ChangeCounts map[string]int `json:"changeCounts"`
+	Changes      []*file        `json:"changes"`
 }
 
 type compare struct {
@@ -304,6 +376,25 @@ func convertChange(from *file) *scm.Change {
 	return returnVal
 }
 
+func convertCommitChangeList(from []*file) []*scm.Change {
+	to := []*scm.Change{}
+	for _, v := range from {
+		if v.Item.IsFolder {
+			continue
+		}
+		change := convertChange(v)
+		change.BlobID = v.Item.ObjectID
+		// the change type of a renamed and modified file is
+		// a comma separated list, for example "edit, rename".
+		if strings.Contains(v.ChangeType, "rename") {
+			change.Renamed = true
+			change.PrevFilePath = v.SourceServerItem
+		}
+		to = append(to, change)
+	}
+	return to
+}
+
 func convertTags(from []*tag) []*scm.Reference {
 	var to []*scm.Reference
 	for _, v := range from {
@@ -234,3 +234,105 @@ func TestConvertTags(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestGitFindBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "heads/main").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.FindBranch(context.Background(), "REPOID", "main")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Reference{
+		Name: "main",
+		Path: "refs/heads/main",
+		Sha:  "8a1218a1024a212bb3db30becd860315f9f3ac52",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitFindBranch_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "heads/mai").
+		Reply(200).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Git.FindBranch(context.Background(), "REPOID", "mai")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+}
+
+func TestGitFindTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/refs").
+		MatchParam("filter", "tags/v1.0.0").
+		MatchParam("peelTags", "true").
+		Reply(200).
+		Type("application/json").
+		File("testdata/tag.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.FindTag(context.Background(), "REPOID", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Reference{
+		Name: "v1.0.0",
+		Path: "refs/tags/v1.0.0",
+		Sha:  "8a1218a1024a212bb3db30becd860315f9f3ac52",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitListChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/commits/8a1218a1024a212bb3db30becd860315f9f3ac52/changes").
+		MatchParam("top", "100").
+		MatchParam("skip", "100").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_changes.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Git.ListChanges(context.Background(), "REPOID", "8a1218a1024a212bb3db30becd860315f9f3ac52", scm.ListOptions{Page: 2, Size: 100})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/commit_changes.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -6,48 +6,229 @@ package azure
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// issueService implements the issue service using Azure
+// Boards work items. Work items belong to the project
+// rather than the repository, so the repository name is
+// ignored.
 type issueService struct {
 	client *wrapper
 }
 
 func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work-items/get-work-item?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workitems/%d?$expand=relations&api-version=6.0",
+		s.client.owner, s.client.project, number)
+	out := new(workItem)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	closed, res, err := s.closedStates(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertIssue(out, closed), res, nil
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/comments/get-comment?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workItems/%d/comments/%d?api-version=6.0-preview.3",
+		s.client.owner, s.client.project, index, id)
+	out := new(workItemComment)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/wiql/query-by-wiql?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	size := opts.Size
+	if size == 0 {
+		size = 100
+	}
+	page := opts.Page
+	if page == 0 {
+		page = 1
+	}
+	closed, res, err := s.closedStates(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	if opts.Closed && !opts.Open && len(closed) == 0 {
+		return []*scm.Issue{}, res, nil
+	}
+	// wiql returns the work item ids without pagination,
+	// limited to the top results, and the work items are
+	// fetched in a separate request.
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/wiql?$top=%d&api-version=6.0",
+		s.client.owner, s.client.project, page*size)
+	in := &wiql{Query: buildIssueQuery(opts, closed)}
+	out := new(wiqlResult)
+	res, err = s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	ids := []string{}
+	for i := (page - 1) * size; i < len(out.WorkItems) && i < page*size; i++ {
+		ids = append(ids, strconv.Itoa(out.WorkItems[i].ID))
+	}
+	if len(ids) == 0 {
+		return []*scm.Issue{}, res, nil
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work-items/list?view=azure-devops-rest-6.0
+	endpoint = fmt.Sprintf("%s/%s/_apis/wit/workitems?ids=%s&$expand=relations&api-version=6.0",
+		s.client.owner, s.client.project, strings.Join(ids, ","))
+	items := new(workItemList)
+	res, err = s.client.do(ctx, "GET", endpoint, nil, items)
+	return convertIssueList(items.Value, closed), res, err
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/comments/get-comments?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// comments are paged using a continuation token, so
+	// the preceding pages are skipped by following the
+	// token.
+	var (
+		out   *workItemCommentList
+		res   *scm.Response
+		err   error
+		token string
+	)
+	for page := 1; page == 1 || page <= opts.Page; page++ {
+		params := url.Values{}
+		if opts.Size != 0 {
+			params.Set("$top", strconv.Itoa(opts.Size))
+		}
+		if token != "" {
+			params.Set("continuationToken", token)
+		}
+		params.Set("api-version", "6.0-preview.3")
+		endpoint := fmt.Sprintf("%s/%s/_apis/wit/workItems/%d/comments?%s",
+			s.client.owner, s.client.project, index, params.Encode())
+		out = new(workItemCommentList)
+		res, err = s.client.do(ctx, "GET", endpoint, nil, out)
+		if err != nil {
+			return nil, res, err
+		}
+		token = out.ContinuationToken
+		if token == "" && page < opts.Page {
+			return []*scm.Comment{}, res, nil
+		}
+	}
+	return convertIssueCommentList(out.Comments), res, nil
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work-items/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workitems/$%s?api-version=6.0",
+		s.client.owner, s.client.project, issueType)
+	in := jsonPatch{
+		{Op: "add", Path: "/fields/System.Title", Value: input.Title},
+		{Op: "add", Path: "/fields/System.Description", Value: input.Body},
+	}
+	out := new(workItem)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertIssue(out, nil), res, err
 }
 
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/comments/add-comment?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workItems/%d/comments?api-version=6.0-preview.3",
+		s.client.owner, s.client.project, number)
+	in := &workItemCommentInput{Text: input.Body}
+	out := new(workItemComment)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *issueService) EditComment(ctx context.Context, repo string, number, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/comments/update-comment?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workItems/%d/comments/%d?api-version=6.0-preview.3",
+		s.client.owner, s.client.project, number, id)
+	in := &workItemCommentInput{Text: input.Body}
+	out := new(workItemComment)
+	res, err := s.client.do(ctx, "PATCH", endpoint, in, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/comments/delete?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workItems/%d/comments/%d?api-version=6.0-preview.3",
+		s.client.owner, s.client.project, number, id)
+	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work-item-type-states/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workitems/%d?api-version=6.0",
+		s.client.owner, s.client.project, number)
+	item := new(workItem)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, item)
+	if err != nil {
+		return res, err
+	}
+	// the state names are defined by the process template,
+	// for example Closed or Done, so the work item is moved
+	// to the first state in the completed category.
+	endpoint = fmt.Sprintf("%s/%s/_apis/wit/workitemtypes/%s/states?api-version=6.0",
+		s.client.owner, s.client.project, url.PathEscape(item.Fields.WorkItemType))
+	states := new(workItemStateList)
+	res, err = s.client.do(ctx, "GET", endpoint, nil, states)
+	if err != nil {
+		return res, err
+	}
+	state := ""
+	for _, v := range states.Value {
+		if v.Category == "Completed" {
+			state = v.Name
+			break
+		}
+	}
+	if state == "" {
+		return res, fmt.Errorf("work item type %s has no completed state", item.Fields.WorkItemType)
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/wit/workitems/%d?api-version=6.0",
+		s.client.owner, s.client.project, number)
+	in := jsonPatch{
+		{Op: "test", Path: "/rev", Value: item.Rev},
+		{Op: "add", Path: "/fields/System.State", Value: state},
+	}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -69,3 +250,295 @@ func (s *issueService) AddReaction(context.Context, string, int, int, *scm.React
 func (s *issueService) DeleteReaction(context.Context, string, int, int, string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+// LinkPullRequest links the work item to the pull request.
+// Linked pull requests are returned in the PullRequest
+// field of the issue.
+func LinkPullRequest(ctx context.Context, client *scm.Client, repo string, issue, number int) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work-items/update?view=azure-devops-rest-6.0#add-a-link
+	s, ok := client.Issues.(*issueService)
+	if !ok {
+		return nil, scm.ErrNotSupported
+	}
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	// the artifact uri is composed of the project and
+	// repository identifiers, which are not known if the
+	// repository is referenced by name.
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	from := new(repository)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, from)
+	if err != nil {
+		return res, err
+	}
+	endpoint = fmt.Sprintf("%s/%s/_apis/wit/workitems/%d?api-version=6.0",
+		s.client.owner, s.client.project, issue)
+	in := jsonPatch{
+		{
+			Op:   "add",
+			Path: "/relations/-",
+			Value: &workItemRelation{
+				Rel: "ArtifactLink",
+				URL: fmt.Sprintf("%s%s%%2F%s%%2F%d", pullRequestArtifact, from.Project.ID, from.ID, number),
+				Attributes: map[string]interface{}{
+					"name": "Pull Request",
+				},
+			},
+		},
+	}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
+}
+
+// issueType is the work item type created by the issue
+// service, which is available in the Basic, Agile and CMMI
+// process templates.
+const issueType = "Issue"
+
+// pullRequestArtifact is the artifact uri prefix of pull
+// request links.
+const pullRequestArtifact = "vstfs:///Git/PullRequestId/"
+
+// closedStates returns the names of the work item states in
+// the completed and removed categories. The state names are
+// defined by the process template, for example Done, Closed
+// or Resolved, so they are read from the work item types.
+// This is the same category lookup used to close an issue.
+func (s *issueService) closedStates(ctx context.Context) ([]string, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/work-item-types/list?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/wit/workitemtypes?api-version=6.0",
+		s.client.owner, s.client.project)
+	out := new(workItemTypeList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	states := []string{}
+	seen := map[string]bool{}
+	for _, v := range out.Value {
+		for _, state := range v.States {
+			if state.Category != "Completed" && state.Category != "Removed" {
+				continue
+			}
+			if !seen[state.Name] {
+				seen[state.Name] = true
+				states = append(states, state.Name)
+			}
+		}
+	}
+	return states, res, nil
+}
+
+// buildIssueQuery returns the wiql query for the list
+// options, ordered by the most recently changed.
+func buildIssueQuery(opts scm.IssueListOptions, closed []string) string {
+	query := "SELECT [System.Id] FROM WorkItems WHERE [System.TeamProject] = @project"
+	var quoted []string
+	for _, state := range closed {
+		quoted = append(quoted, "'"+strings.ReplaceAll(state, "'", "''")+"'")
+	}
+	states := strings.Join(quoted, ", ")
+	switch {
+	case opts.Open && !opts.Closed && len(closed) != 0:
+		query += " AND [System.State] NOT IN (" + states + ")"
+	case opts.Closed && !opts.Open:
+		query += " AND [System.State] IN (" + states + ")"
+	}
+	return query + " ORDER BY [System.ChangedDate] DESC"
+}
+
+type jsonPatch []*patchOperation
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+type wiql struct {
+	Query string `json:"query"`
+}
+
+type wiqlResult struct {
+	WorkItems []struct {
+		ID  int    `json:"id"`
+		URL string `json:"url"`
+	} `json:"workItems"`
+}
+
+type workItemList struct {
+	Count int         `json:"count"`
+	Value []*workItem `json:"value"`
+}
+
+type workItem struct {
+	ID     int `json:"id"`
+	Rev    int `json:"rev"`
+	Fields struct {
+		Title        string      `json:"System.Title"`
+		Description  string      `json:"System.Description"`
+		State        string      `json:"System.State"`
+		Tags         string      `json:"System.Tags"`
+		WorkItemType string      `json:"System.WorkItemType"`
+		CreatedBy    identityRef `json:"System.CreatedBy"`
+		CreatedDate  time.Time   `json:"System.CreatedDate"`
+		ChangedDate  time.Time   `json:"System.ChangedDate"`
+	} `json:"fields"`
+	Relations []*workItemRelation `json:"relations"`
+	Links     struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"_links"`
+	URL string `json:"url"`
+}
+
+type workItemRelation struct {
+	Rel        string                 `json:"rel"`
+	URL        string                 `json:"url"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+type workItemStateList struct {
+	Count int              `json:"count"`
+	Value []*workItemState `json:"value"`
+}
+
+type workItemState struct {
+	Name     string `json:"name"`
+	Color    string `json:"color"`
+	Category string `json:"category"`
+}
+
+type workItemTypeList struct {
+	Count int `json:"count"`
+	Value []struct {
+		Name   string           `json:"name"`
+		States []*workItemState `json:"states"`
+	} `json:"value"`
+}
+
+type workItemCommentList struct {
+	TotalCount        int                `json:"totalCount"`
+	Count             int                `json:"count"`
+	Comments          []*workItemComment `json:"comments"`
+	ContinuationToken string             `json:"continuationToken"`
+}
+
+type workItemComment struct {
+	WorkItemID   int         `json:"workItemId"`
+	ID           int         `json:"id"`
+	Version      int         `json:"version"`
+	Text         string      `json:"text"`
+	CreatedBy    identityRef `json:"createdBy"`
+	CreatedDate  time.Time   `json:"createdDate"`
+	ModifiedDate time.Time   `json:"modifiedDate"`
+	IsDeleted    bool        `json:"isDeleted"`
+}
+
+type workItemCommentInput struct {
+	Text string `json:"text"`
+}
+
+type identityRef struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	ImageURL    string `json:"imageUrl"`
+}
+
+func convertIssueList(from []*workItem, closed []string) []*scm.Issue {
+	to := []*scm.Issue{}
+	for _, v := range from {
+		to = append(to, convertIssue(v, closed))
+	}
+	return to
+}
+
+func convertIssue(from *workItem, closed []string) *scm.Issue {
+	to := &scm.Issue{
+		Number:  from.ID,
+		Title:   from.Fields.Title,
+		Body:    from.Fields.Description,
+		Link:    from.Links.HTML.Href,
+		Labels:  convertTagList(from.Fields.Tags),
+		Closed:  isClosedState(from.Fields.State, closed),
+		Author:  convertIdentityRef(from.Fields.CreatedBy),
+		Created: from.Fields.CreatedDate,
+		Updated: from.Fields.ChangedDate,
+	}
+	for _, v := range from.Relations {
+		if number, ok := parsePullRequestArtifact(v.URL); ok {
+			to.PullRequest = scm.PullRequest{Number: number}
+			break
+		}
+	}
+	return to
+}
+
+func convertIssueCommentList(from []*workItemComment) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range from {
+		if !v.IsDeleted {
+			to = append(to, convertIssueComment(v))
+		}
+	}
+	return to
+}
+
+func convertIssueComment(from *workItemComment) *scm.Comment {
+	return &scm.Comment{
+		ID:      from.ID,
+		Body:    from.Text,
+		Author:  convertIdentityRef(from.CreatedBy),
+		Created: from.CreatedDate,
+		Updated: from.ModifiedDate,
+	}
+}
+
+func convertIdentityRef(from identityRef) scm.User {
+	return scm.User{
+		ID:     from.ID,
+		Login:  from.UniqueName,
+		Name:   from.DisplayName,
+		Avatar: from.ImageURL,
+	}
+}
+
+// convertTagList returns the work item tags, which are
+// separated by semicolons.
+func convertTagList(from string) []string {
+	var to []string
+	for _, v := range strings.Split(from, ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			to = append(to, v)
+		}
+	}
+	return to
+}
+
+func isClosedState(state string, closed []string) bool {
+	for _, v := range closed {
+		if strings.EqualFold(v, state) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePullRequestArtifact returns the pull request number
+// from the pull request artifact uri.
+func parsePullRequestArtifact(uri string) (int, bool) {
+	if !strings.HasPrefix(uri, pullRequestArtifact) {
+		return 0, false
+	}
+	i := strings.LastIndex(uri, "%2F")
+	if i == -1 {
+		return 0, false
+	}
+	number, err := strconv.Atoi(uri[i+3:])
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
@@ -0,0 +1,337 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestIssueFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitems/42").
+		MatchParam("$expand", "relations").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitemtypes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_types.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.Find(context.Background(), "REPOID", 42)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/workitem.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitemtypes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_types.json")
+
+	// the closed states are read from the state categories
+	// of the work item types, including custom states.
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/wit/wiql").
+		MatchParam("$top", "4").
+		BodyString(`NOT IN \('Done', 'Resolved', 'Removed'\)`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/wiql.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitems").
+		MatchParam("ids", "^41$").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitems.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.List(context.Background(), "REPOID", scm.IssueListOptions{Page: 2, Size: 2, Open: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/workitems.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueList_Closed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitemtypes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_types.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/wit/wiql").
+		BodyString(`\[System.State\] IN \('Done', 'Resolved', 'Removed'\)`).
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"workItems":[]}`)
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.List(context.Background(), "REPOID", scm.IssueListOptions{Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(got) != 0 {
+		t.Errorf("Want no issues, got %d", len(got))
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestIssueCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/wit/workitems/\\$Issue").
+		MatchHeader("Content-Type", "application/json-patch\\+json").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem.json")
+
+	input := &scm.IssueInput{
+		Title: "Login fails",
+		Body:  "<div>Found a bug</div>",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.Create(context.Background(), "REPOID", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/workitem.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitems/42").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workitemtypes/Issue/states").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_states.json")
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/wit/workitems/42").
+		MatchHeader("Content-Type", "application/json-patch\\+json").
+		BodyString(`"path":"/fields/System.State","value":"Done"`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := client.Issues.Close(context.Background(), "REPOID", 42)
+	if err != nil {
+		t.Error(err)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expected the work item state updated")
+	}
+}
+
+func TestIssueFindComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workItems/42/comments/3").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_comment.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.FindComment(context.Background(), "REPOID", 42, 3)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/workitem_comment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueListComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/wit/workItems/42/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_comments.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.ListComments(context.Background(), "REPOID", 42, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/workitem_comments.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueCreateComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/wit/workItems/42/comments").
+		JSON(map[string]string{"text": "Fixed in #19"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_comment.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.CreateComment(context.Background(), "REPOID", 42, &scm.CommentInput{Body: "Fixed in #19"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/workitem_comment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueEditComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/wit/workItems/42/comments/3").
+		JSON(map[string]string{"text": "Fixed in #19"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem_comment.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Issues.EditComment(context.Background(), "REPOID", 42, 3, &scm.CommentInput{Body: "Fixed in #19"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/workitem_comment.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueDeleteComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/wit/workItems/42/comments/3").
+		Reply(204)
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.Issues.DeleteComment(context.Background(), "REPOID", 42, 3)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestLinkPullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/test_project").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/wit/workitems/42").
+		BodyString(`"url":"vstfs:///Git/PullRequestId/d350c9c0-7749-4ff8-a78f-f9c1f0e56729%2F91f0d4cb-4c36-49a5-b28d-2d72da089c4d%2F19"`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/workitem.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, err := LinkPullRequest(context.Background(), client, "test_project", 42, 19)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -6,20 +6,91 @@ package azure
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
 
 type linker struct {
-	base string
+	base    string
+	owner   string
+	project string
 }
 
 // Resource returns a link to the resource.
 func (l *linker) Resource(ctx context.Context, repo string, ref scm.Reference) (string, error) {
-	return "", scm.ErrNotSupported
+	base, err := l.repository(repo)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case scm.IsTag(ref.Path):
+		t := scm.TrimRef(ref.Path)
+		return fmt.Sprintf("%s?version=GT%s", base, url.QueryEscape(t)), nil
+	case scm.IsPullRequest(ref.Path):
+		d := scm.ExtractPullRequest(ref.Path)
+		return fmt.Sprintf("%s/pullrequest/%d", base, d), nil
+	case ref.Sha == "":
+		t := scm.TrimRef(ref.Path)
+		return fmt.Sprintf("%s?version=GB%s", base, url.QueryEscape(t)), nil
+	default:
+		return fmt.Sprintf("%s/commit/%s", base, ref.Sha), nil
+	}
 }
 
 // Diff returns a link to the diff.
 func (l *linker) Diff(ctx context.Context, repo string, source, target scm.Reference) (string, error) {
-	return "", scm.ErrNotSupported
+	base, err := l.repository(repo)
+	if err != nil {
+		return "", err
+	}
+	if scm.IsPullRequest(target.Path) {
+		d := scm.ExtractPullRequest(target.Path)
+		return fmt.Sprintf("%s/pullrequest/%d?_a=files", base, d), nil
+	}
+	// azure compares the target version to the base
+	// version, which is the reverse of the source and
+	// target order used by the other providers.
+	params := url.Values{}
+	params.Set("baseVersion", linkVersion(target))
+	params.Set("targetVersion", linkVersion(source))
+	return fmt.Sprintf("%s/branchCompare?%s", base, params.Encode()), nil
+}
+
+// repository returns the web address of the repository.
+// The hosted service includes the organization in the
+// path, unless the legacy visualstudio.com domain is used,
+// and the server includes the project collection.
+func (l *linker) repository(repo string) (string, error) {
+	if l.project == "" {
+		return "", ProjectRequiredError()
+	}
+	base := l.base
+	if !strings.HasSuffix(base, "/") {
+		base = base + "/"
+	}
+	uri, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasSuffix(strings.ToLower(uri.Hostname()), ".visualstudio.com") {
+		return fmt.Sprintf("%s%s/_git/%s", base, l.project, repo), nil
+	}
+	return fmt.Sprintf("%s%s/%s/_git/%s", base, l.owner, l.project, repo), nil
+}
+
+// linkVersion returns the version descriptor of the
+// reference, which is prefixed with GC for commits, GT for
+// tags and GB for branches.
+func linkVersion(ref scm.Reference) string {
+	switch {
+	case ref.Sha != "":
+		return "GC" + ref.Sha
+	case scm.IsTag(ref.Path):
+		return "GT" + scm.TrimRef(ref.Path)
+	default:
+		return "GB" + scm.TrimRef(ref.Path)
+	}
 }
@@ -0,0 +1,128 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestLink(t *testing.T) {
+	tests := []struct {
+		path string
+		sha  string
+		want string
+	}{
+		{
+			path: "refs/heads/main",
+			sha:  "a7389057b0eb027e73b32a81e3c5923a71d01dde",
+			want: "https://dev.azure.com/ORG/PROJ/_git/REPO/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde",
+		},
+		{
+			path: "refs/pull/42/merge",
+			sha:  "a7389057b0eb027e73b32a81e3c5923a71d01dde",
+			want: "https://dev.azure.com/ORG/PROJ/_git/REPO/pullrequest/42",
+		},
+		{
+			path: "refs/tags/v1.0.0",
+			want: "https://dev.azure.com/ORG/PROJ/_git/REPO?version=GTv1.0.0",
+		},
+		{
+			path: "refs/heads/feature/login",
+			want: "https://dev.azure.com/ORG/PROJ/_git/REPO?version=GBfeature%2Flogin",
+		},
+	}
+
+	for _, test := range tests {
+		client := NewDefault("ORG", "PROJ")
+		ref := scm.Reference{
+			Path: test.path,
+			Sha:  test.sha,
+		}
+		got, err := client.Linker.Resource(context.Background(), "REPO", ref)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		want := test.want
+		if got != want {
+			t.Errorf("Want link %q, got %q", want, got)
+		}
+	}
+}
+
+func TestLink_Server(t *testing.T) {
+	tests := []struct {
+		server string
+		owner  string
+		want   string
+	}{
+		{
+			server: "https://tfs.example.com/tfs",
+			owner:  "DefaultCollection",
+			want:   "https://tfs.example.com/tfs/DefaultCollection/PROJ/_git/REPO/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde",
+		},
+		{
+			server: "https://ORG.visualstudio.com",
+			owner:  "ORG",
+			want:   "https://ORG.visualstudio.com/PROJ/_git/REPO/commit/a7389057b0eb027e73b32a81e3c5923a71d01dde",
+		},
+	}
+
+	for _, test := range tests {
+		client, _ := New(test.server, test.owner, "PROJ")
+		ref := scm.Reference{
+			Path: "refs/heads/main",
+			Sha:  "a7389057b0eb027e73b32a81e3c5923a71d01dde",
+		}
+		got, err := client.Linker.Resource(context.Background(), "REPO", ref)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if want := test.want; got != want {
+			t.Errorf("Want link %q, got %q", want, got)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		source scm.Reference
+		target scm.Reference
+		want   string
+	}{
+		{
+			source: scm.Reference{Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"},
+			target: scm.Reference{Sha: "49bbaf4a113bbebfa21cf604cad9aa1503c3f04d"},
+			want:   "https://dev.azure.com/ORG/PROJ/_git/REPO/branchCompare?baseVersion=GC49bbaf4a113bbebfa21cf604cad9aa1503c3f04d&targetVersion=GCa7389057b0eb027e73b32a81e3c5923a71d01dde",
+		},
+		{
+			source: scm.Reference{Path: "refs/heads/feature"},
+			target: scm.Reference{Path: "refs/heads/main"},
+			want:   "https://dev.azure.com/ORG/PROJ/_git/REPO/branchCompare?baseVersion=GBmain&targetVersion=GBfeature",
+		},
+		{
+			source: scm.Reference{Sha: "a7389057b0eb027e73b32a81e3c5923a71d01dde"},
+			target: scm.Reference{Path: "refs/pull/12/merge"},
+			want:   "https://dev.azure.com/ORG/PROJ/_git/REPO/pullrequest/12?_a=files",
+		},
+	}
+
+	for _, test := range tests {
+		client := NewDefault("ORG", "PROJ")
+		got, err := client.Linker.Diff(context.Background(), "REPO", test.source, test.target)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		want := test.want
+		if got != want {
+			t.Errorf("Want link %q, got %q", want, got)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package azure
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,13 +16,112 @@ type organizationService struct {
 }
 
 func (s *organizationService) Find(ctx context.Context, name string) (*scm.Organization, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// the connection data is returned for organizations, or
+	// project collections, the user is able to access.
+	endpoint := fmt.Sprintf("%s/_apis/connectionData", name)
+	out := new(connectionData)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return &scm.Organization{Name: name}, res, nil
 }
 
 func (s *organizationService) FindMembership(ctx context.Context, name, username string) (*scm.Membership, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/ims/identities/read-identities?view=azure-devops-rest-6.0
+	user, res, err := s.client.findIdentity(ctx, name, username, false)
+	if err != nil {
+		return nil, res, err
+	}
+	// members of the project collection administrators
+	// group are organization administrators.
+	group := fmt.Sprintf("[%s]\\Project Collection Administrators", name)
+	admins, res, err := s.client.findIdentity(ctx, name, group, true)
+	if err != nil {
+		return nil, res, err
+	}
+	out := &scm.Membership{
+		Active: user.IsActive,
+		Role:   scm.RoleMember,
+	}
+	for _, member := range admins.Members {
+		if member == user.Descriptor {
+			out.Role = scm.RoleAdmin
+			break
+		}
+	}
+	return out, res, nil
 }
 
 func (s *organizationService) List(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	if !s.client.hosted() {
+		return s.listCollections(ctx, opts)
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/profile/profiles/get?view=azure-devops-rest-6.0
+	profile := new(profile)
+	res, err := s.client.do(ctx, "GET", "https://app.vssps.visualstudio.com/_apis/profile/profiles/me?api-version=6.0", nil, profile)
+	if err != nil {
+		return nil, res, err
+	}
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/account/accounts/list?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("https://app.vssps.visualstudio.com/_apis/accounts?memberId=%s&api-version=6.0", profile.ID)
+	out := new(accountList)
+	res, err = s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertAccountList(out.Value), res, err
+}
+
+// listCollections returns the project collections of the
+// Azure DevOps Server, which are the equivalent of the
+// hosted service organizations.
+func (s *organizationService) listCollections(ctx context.Context, opts scm.ListOptions) ([]*scm.Organization, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/project-collections/list?view=azure-devops-server-rest-6.0
+	endpoint := fmt.Sprintf("_apis/projectCollections?%s&api-version=6.0", encodeTopSkip(opts))
+	out := new(collectionList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertCollectionList(out.Value), res, err
+}
+
+type profile struct {
+	ID           string `json:"id"`
+	DisplayName  string `json:"displayName"`
+	PublicAlias  string `json:"publicAlias"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+type accountList struct {
+	Count int        `json:"count"`
+	Value []*account `json:"value"`
+}
+
+type account struct {
+	AccountID   string `json:"accountId"`
+	AccountName string `json:"accountName"`
+	AccountURI  string `json:"accountUri"`
+}
+
+type collectionList struct {
+	Count int           `json:"count"`
+	Value []*collection `json:"value"`
+}
+
+type collection struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+func convertAccountList(from []*account) []*scm.Organization {
+	to := []*scm.Organization{}
+	for _, v := range from {
+		to = append(to, &scm.Organization{Name: v.AccountName})
+	}
+	return to
+}
+
+func convertCollectionList(from []*collection) []*scm.Organization {
+	to := []*scm.Organization{}
+	for _, v := range from {
+		to = append(to, &scm.Organization{Name: v.Name})
+	}
+	return to
 }
@@ -0,0 +1,129 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestOrganizationFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/tphoney/_apis/connectionData").
+		Reply(200).
+		Type("application/json").
+		File("testdata/connection_data.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.Find(context.Background(), "tphoney")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Organization{Name: "tphoney"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationFindMembership(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://vssps.dev.azure.com/").
+		Get("/ORG/_apis/identities").
+		MatchParam("filterValue", "tp@harness.io").
+		MatchParam("queryMembership", "None").
+		Reply(200).
+		Type("application/json").
+		File("testdata/identities.json")
+
+	gock.New("https://vssps.dev.azure.com/").
+		Get("/ORG/_apis/identities").
+		MatchParam("filterValue", `\[ORG\]\\Project Collection Administrators`).
+		MatchParam("queryMembership", "Expanded").
+		Reply(200).
+		Type("application/json").
+		File("testdata/identities_admins.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.FindMembership(context.Background(), "ORG", "tp@harness.io")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Membership{Active: true, Role: scm.RoleAdmin}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://app.vssps.visualstudio.com/").
+		Get("/_apis/profile/profiles/me").
+		Reply(200).
+		Type("application/json").
+		File("testdata/profile.json")
+
+	gock.New("https://app.vssps.visualstudio.com/").
+		Get("/_apis/accounts").
+		MatchParam("memberId", "3ff4a20f-306e-677e-8a01-57f35e71f109").
+		Reply(200).
+		Type("application/json").
+		File("testdata/accounts.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Organizations.List(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Organization{}
+	raw, _ := ioutil.ReadFile("testdata/orgs.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestOrganizationList_Server(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://tfs.example.com/tfs/").
+		Get("/_apis/projectCollections").
+		Reply(200).
+		Type("application/json").
+		File("testdata/collections.json")
+
+	client, _ := New("https://tfs.example.com/tfs", "DefaultCollection", "PROJ")
+	got, _, err := client.Organizations.List(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Organization{{Name: "DefaultCollection"}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -7,6 +7,8 @@ package azure
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -27,15 +29,109 @@ func (s *pullService) Find(ctx context.Context, repo string, number int) (*scm.P
 }
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/get-pull-requests?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	switch {
+	case opts.Open && !opts.Closed:
+		out, res, err := s.list(ctx, repo, "active", opts)
+		return convertPullRequestList(out), res, err
+	case opts.Closed && !opts.Open:
+		return s.listClosed(ctx, repo, opts)
+	default:
+		out, res, err := s.list(ctx, repo, "all", opts)
+		return convertPullRequestList(out), res, err
+	}
+}
+
+// listClosed returns the completed and abandoned pull
+// requests, most recently closed first. Azure cannot filter
+// by both statuses at once, so the pull requests up to and
+// including the requested page are listed for each status,
+// merged, and the requested page is returned.
+func (s *pullService) listClosed(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
+	window := opts
+	if opts.Size != 0 && opts.Page > 1 {
+		window.Page = 1
+		window.Size = opts.Page * opts.Size
+	}
+	var all []*pr
+	var res *scm.Response
+	for _, status := range []string{"completed", "abandoned"} {
+		out, r, err := s.list(ctx, repo, status, window)
+		res = r
+		if err != nil {
+			return nil, res, err
+		}
+		all = append(all, out...)
+	}
+	// the closed dates are fixed format timestamps, which
+	// sort in date order.
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].ClosedDate.String > all[j].ClosedDate.String
+	})
+	if opts.Size != 0 {
+		lo, hi := 0, opts.Size
+		if opts.Page > 1 {
+			lo, hi = (opts.Page-1)*opts.Size, opts.Page*opts.Size
+		}
+		if lo > len(all) {
+			lo = len(all)
+		}
+		if hi > len(all) {
+			hi = len(all)
+		}
+		all = all[lo:hi]
+	}
+	return convertPullRequestList(all), res, nil
+}
+
+func (s *pullService) list(ctx context.Context, repo, status string, opts scm.PullRequestListOptions) ([]*pr, *scm.Response, error) {
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests?%s&api-version=6.0",
+		s.client.owner, s.client.project, repo, encodePullRequestListOptions(status, opts))
+	out := new(prList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return out.Value, res, err
 }
 
 func (s *pullService) ListChanges(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-iteration-changes/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	iteration, res, err := s.lastIteration(ctx, repo, number)
+	if err != nil {
+		return nil, res, err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/iterations/%d/changes?%s&api-version=6.0",
+		s.client.owner, s.client.project, repo, number, iteration, encodeTopSkip(opts))
+	out := new(iterationChanges)
+	res, err = s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertIterationChangeList(out.ChangeEntries), res, err
 }
 
 func (s *pullService) GetPRFileDiff(ctx context.Context, repo string, prNumber int, path string) (*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// azure does not return the file patch, and does not
+	// provide pagination links, so the changes are paged
+	// until a short page is returned.
+	path = "/" + strings.TrimPrefix(path, "/")
+	opts := scm.ListOptions{Page: 1, Size: maxChangesPageSize}
+	for {
+		changes, res, err := s.ListChanges(ctx, repo, prNumber, opts)
+		if err != nil {
+			return nil, res, err
+		}
+		for _, change := range changes {
+			if change.Path == path || change.PrevFilePath == path {
+				return change, res, nil
+			}
+		}
+		if len(changes) < opts.Size {
+			return nil, res, nil
+		}
+		opts.Page++
+	}
 }
 
 func (s *pullService) ListCommits(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
@@ -48,13 +144,86 @@ func (s *pullService) ListCommits(ctx context.Context, repo string, number int,
 }
 
 func (s *pullService) Merge(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	// completing a pull request requires the last merge
+	// source commit, which guards against merging commits
+	// pushed after the pull request was reviewed.
+	current, res, err := s.Find(ctx, repo, number)
+	if err != nil {
+		return res, err
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &prUpdate{Status: "completed"}
+	in.LastMergeSourceCommit = &prCommitRef{CommitID: current.Sha}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
 }
 
 func (s *pullService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &prUpdate{Status: "abandoned"}
+	return s.client.do(ctx, "PATCH", endpoint, in, nil)
+}
+
+// FindComment is not supported. The issue service is
+// backed by work items, and the pull request comments are
+// stored in comment threads.
+func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListComments is not supported: see FindComment.
+func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// CreateComment is not supported: see FindComment.
+func (s *pullService) CreateComment(context.Context, string, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// EditComment is not supported: see FindComment.
+func (s *pullService) EditComment(context.Context, string, int, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// DeleteComment is not supported: see FindComment.
+func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// lastIteration returns the id of the latest pull request
+// iteration. An iteration is created each time commits are
+// pushed to the source branch.
+func (s *pullService) lastIteration(ctx context.Context, repo string, number int) (int, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-iterations/list?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/iterations?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	out := new(iterationList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return 0, res, err
+	}
+	id := 0
+	for _, v := range out.Value {
+		if v.ID > id {
+			id = v.ID
+		}
+	}
+	if id == 0 {
+		return 0, res, scm.ErrNotFound
+	}
+	return id, res, nil
+}
+
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/create?view=azure-devops-rest-6.0
 	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests?api-version=6.0", s.client.owner, s.client.project, repo)
@@ -69,6 +238,47 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return convertPullRequest(out), res, err
 }
 
+// maxChangesPageSize is the page size used when searching
+// the pull request changes for a file.
+const maxChangesPageSize = 100
+
+type prList struct {
+	Value []*pr `json:"value"`
+	Count int   `json:"count"`
+}
+
+type prUpdate struct {
+	Status                string       `json:"status"`
+	LastMergeSourceCommit *prCommitRef `json:"lastMergeSourceCommit,omitempty"`
+}
+
+type prCommitRef struct {
+	CommitID string `json:"commitId"`
+}
+
+type iterationList struct {
+	Value []struct {
+		ID int `json:"id"`
+	} `json:"value"`
+	Count int `json:"count"`
+}
+
+type iterationChanges struct {
+	ChangeEntries []*iterationChange `json:"changeEntries"`
+}
+
+type iterationChange struct {
+	ChangeTrackingID int    `json:"changeTrackingId"`
+	ChangeID         int    `json:"changeId"`
+	ChangeType       string `json:"changeType"`
+	OriginalPath     string `json:"originalPath"`
+	Item             struct {
+		ObjectID         string `json:"objectId"`
+		OriginalObjectID string `json:"originalObjectId"`
+		Path             string `json:"path"`
+	} `json:"item"`
+}
+
 type prInput struct {
 	SourceRefName string `json:"sourceRefName"`
 	TargetRefName string `json:"targetRefName"`
@@ -164,6 +374,14 @@ type pr struct {
 	ArtifactID         string `json:"artifactId"`
 }
 
+func convertPullRequestList(from []*pr) []*scm.PullRequest {
+	to := []*scm.PullRequest{}
+	for _, v := range from {
+		to = append(to, convertPullRequest(v))
+	}
+	return to
+}
+
 func convertPullRequest(from *pr) *scm.PullRequest {
 	return &scm.PullRequest{
 		Number: from.PullRequestID,
@@ -191,3 +409,32 @@ func convertPullRequest(from *pr) *scm.PullRequest {
 		Created: from.CreationDate,
 	}
 }
+
+func convertIterationChangeList(from []*iterationChange) []*scm.Change {
+	to := []*scm.Change{}
+	for _, v := range from {
+		to = append(to, convertIterationChange(v))
+	}
+	return to
+}
+
+func convertIterationChange(from *iterationChange) *scm.Change {
+	to := &scm.Change{
+		Path:         from.Item.Path,
+		BlobID:       from.Item.ObjectID,
+		PrevFilePath: from.OriginalPath,
+	}
+	// the change type is a comma separated list of flags,
+	// for example "edit, rename".
+	for _, v := range strings.Split(from.ChangeType, ",") {
+		switch strings.TrimSpace(v) {
+		case "add":
+			to.Added = true
+		case "delete":
+			to.Deleted = true
+		case "rename":
+			to.Renamed = true
+		}
+	}
+	return to
+}
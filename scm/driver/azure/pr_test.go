@@ -100,3 +100,223 @@ func TestPullListCommits(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestPullList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests").
+		MatchParam("searchCriteria.status", "all").
+		MatchParam("$top", "25").
+		MatchParam("$skip", "25").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.PullRequests.List(context.Background(), "REPOID", scm.PullRequestListOptions{Page: 2, Size: 25, Open: true, Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.PullRequest{}
+	raw, _ := ioutil.ReadFile("testdata/prs.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullList_Open(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests").
+		MatchParam("searchCriteria.status", "active").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs.json")
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.PullRequests.List(context.Background(), "REPOID", scm.PullRequestListOptions{Open: true})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPullList_Closed(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests").
+		MatchParam("searchCriteria.status", "completed").
+		MatchParam("$top", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs_completed.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests").
+		MatchParam("searchCriteria.status", "abandoned").
+		MatchParam("$top", "2").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prs_abandoned.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.PullRequests.List(context.Background(), "REPOID", scm.PullRequestListOptions{Page: 2, Size: 1, Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// the pull requests are merged most recently closed
+	// first, so the second page is the abandoned pull
+	// request.
+	if len(got) != 1 || got[0].Number != 18 {
+		t.Errorf("Want the second most recently closed pull request returned")
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestPullListChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/iterations").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_iterations.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/iterations/2/changes").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_changes.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.PullRequests.ListChanges(context.Background(), "REPOID", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/pr_changes.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullGetPRFileDiff(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/iterations").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_iterations.json")
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/iterations/2/changes").
+		MatchParam("$top", "100").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr_changes.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.PullRequests.GetPRFileDiff(context.Background(), "REPOID", 1, "docs/usage.md")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/pr_changes.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[2]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestPullMerge(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		JSON(map[string]interface{}{
+			"status": "completed",
+			"lastMergeSourceCommit": map[string]string{
+				"commitId": "01768d964c03e97260af0bd8cd9e5cd1f9ac6356",
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.PullRequests.Merge(context.Background(), "REPOID", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestPullMerge_ProjectRequired(t *testing.T) {
+	client := NewDefault("ORG", "")
+	_, err := client.PullRequests.Merge(context.Background(), "REPOID", 1)
+	if err == nil {
+		t.Errorf("Expect project required error")
+	}
+}
+
+func TestPullClose(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Patch("/ORG/PROJ/_apis/git/repositories/REPOID/pullrequests/1").
+		JSON(map[string]interface{}{"status": "abandoned"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/pr.json")
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.PullRequests.Close(context.Background(), "REPOID", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+}
+
+func TestPullClose_ProjectRequired(t *testing.T) {
+	client := NewDefault("ORG", "")
+	_, err := client.PullRequests.Close(context.Background(), "REPOID", 1)
+	if err == nil {
+		t.Errorf("Expect project required error")
+	}
+}
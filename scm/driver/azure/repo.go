@@ -6,6 +6,7 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -39,7 +40,41 @@ func (s *RepositoryService) FindHook(ctx context.Context, repo string, id string
 
 // FindPerms returns the repository permissions.
 func (s *RepositoryService) FindPerms(ctx context.Context, repo string) (*scm.Perm, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/security/permissions/has-permissions-batch?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	// the security token is composed of the project and
+	// repository identifiers, which are not known if the
+	// repository is referenced by name.
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s?api-version=6.0", s.client.owner, s.client.project, repo)
+	from := new(repository)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, from)
+	if err != nil {
+		return nil, res, err
+	}
+	token := fmt.Sprintf("repoV2/%s/%s", from.Project.ID, from.ID)
+	in := &permissionBatch{
+		Evaluations: []*permissionEvaluation{
+			{SecurityNamespaceID: gitNamespace, Token: token, Permissions: gitRead},
+			{SecurityNamespaceID: gitNamespace, Token: token, Permissions: gitContribute},
+			{SecurityNamespaceID: gitNamespace, Token: token, Permissions: gitManagePermissions},
+		},
+	}
+	endpoint = fmt.Sprintf("%s/_apis/security/permissionevaluationbatch?api-version=6.0", s.client.owner)
+	out := new(permissionBatch)
+	res, err = s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Evaluations) != len(in.Evaluations) {
+		return nil, res, errors.New("unexpected permission evaluation response")
+	}
+	return &scm.Perm{
+		Pull:  out.Evaluations[0].Value,
+		Push:  out.Evaluations[1].Value,
+		Admin: out.Evaluations[2].Value,
+	}, res, nil
 }
 
 // List returns the user repository list.
@@ -87,7 +122,14 @@ func (s *RepositoryService) ListHooks(ctx context.Context, repo string, opts scm
 
 // ListStatus returns a list of commit statuses.
 func (s *RepositoryService) ListStatus(ctx context.Context, repo, ref string, opts scm.ListOptions) ([]*scm.Status, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/statuses/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s?%s&api-version=6.0", s.statusPath(repo, ref), encodeTopSkip(opts))
+	out := new(statusList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertStatusList(out.Value), res, err
 }
 
 // CreateHook creates a new repository webhook.
@@ -134,7 +176,33 @@ func (s *RepositoryService) CreateHook(ctx context.Context, repo string, input *
 
 // CreateStatus creates a new commit status.
 func (s *RepositoryService) CreateStatus(ctx context.Context, repo, ref string, input *scm.StatusInput) (*scm.Status, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/statuses/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s?api-version=6.0", s.statusPath(repo, ref))
+	in := &status{
+		State:       convertFromState(input.State),
+		Description: input.Desc,
+		TargetURL:   input.Target,
+	}
+	in.Context.Genre, in.Context.Name = splitStatusLabel(input.Label)
+	out := new(status)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertStatus(out), res, err
+}
+
+// statusPath returns the statuses path for the ref. The
+// pull request statuses are used if the ref is a pull
+// request reference, for example refs/pull/1/merge, and
+// the commit statuses otherwise.
+func (s *RepositoryService) statusPath(repo, ref string) string {
+	if number, ok := parsePullRef(ref); ok {
+		return fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/statuses",
+			s.client.owner, s.client.project, repo, number)
+	}
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s/statuses",
+		s.client.owner, s.client.project, repo, ref)
 }
 
 // CreateDeployStatus creates a new deployment status.
@@ -157,6 +225,28 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", endpoint, nil, nil)
 }
 
+// FindHookDelivery is not supported: the driver does not
+// map the service hook notifications to hook deliveries.
+func (s *RepositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// ListHookDeliveries is not supported: see FindHookDelivery.
+func (s *RepositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
+// RedeliverHook is not supported: azure cannot resend a
+// service hook notification.
+func (s *RepositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// PingHook is not supported: see FindHookDelivery.
+func (s *RepositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
 // helper function to return the projectID from the project name
 func (s *RepositoryService) getProjectIDFromProjectName(ctx context.Context, projectName string) (string, error) {
 	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/projects/list?view=azure-devops-rest-6.0
@@ -191,6 +281,29 @@ func (s *RepositoryService) getProjectIDFromProjectName(ctx context.Context, pro
 	return "", fmt.Errorf("failed to find project id for %s", projectName)
 }
 
+// gitNamespace is the identifier of the Git Repositories
+// security namespace.
+const gitNamespace = "2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87"
+
+// Git Repositories security namespace permission bits.
+const (
+	gitRead              = 2
+	gitContribute        = 4
+	gitManagePermissions = 8192
+)
+
+type permissionBatch struct {
+	AlwaysAllowAdministrators bool                    `json:"alwaysAllowAdministrators"`
+	Evaluations               []*permissionEvaluation `json:"evaluations"`
+}
+
+type permissionEvaluation struct {
+	SecurityNamespaceID string `json:"securityNamespaceId"`
+	Token               string `json:"token"`
+	Permissions         int    `json:"permissions"`
+	Value               bool   `json:"value"`
+}
+
 type repositories struct {
 	Count int64         `json:"count"`
 	Value []*repository `json:"value"`
@@ -269,6 +382,22 @@ type subscription struct {
 	URL             string `json:"url"`
 }
 
+type statusList struct {
+	Value []*status `json:"value"`
+	Count int       `json:"count"`
+}
+
+type status struct {
+	ID          int    `json:"id,omitempty"`
+	State       string `json:"state"`
+	Description string `json:"description,omitempty"`
+	TargetURL   string `json:"targetUrl,omitempty"`
+	Context     struct {
+		Name  string `json:"name"`
+		Genre string `json:"genre,omitempty"`
+	} `json:"context"`
+}
+
 // helper function to convert from the azure devops repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories, owner string) []*scm.Repository {
@@ -317,3 +446,68 @@ func convertHook(from *subscription) *scm.Hook {
 
 	return returnVal
 }
+
+func convertStatusList(from []*status) []*scm.Status {
+	to := []*scm.Status{}
+	for _, v := range from {
+		to = append(to, convertStatus(v))
+	}
+	return to
+}
+
+func convertStatus(from *status) *scm.Status {
+	label := from.Context.Name
+	if from.Context.Genre != "" {
+		label = from.Context.Genre + "/" + label
+	}
+	return &scm.Status{
+		State:  convertState(from.State),
+		Label:  label,
+		Desc:   from.Description,
+		Target: from.TargetURL,
+	}
+}
+
+// splitStatusLabel splits the status label into the azure
+// status genre and name. The genre is the label prefix up
+// to the last slash, for example continuous-integration.
+func splitStatusLabel(label string) (genre, name string) {
+	if i := strings.LastIndex(label, "/"); i != -1 {
+		return label[:i], label[i+1:]
+	}
+	return "", label
+}
+
+func convertState(from string) scm.State {
+	switch from {
+	case "pending":
+		return scm.StatePending
+	case "succeeded":
+		return scm.StateSuccess
+	case "failed":
+		return scm.StateFailure
+	case "error":
+		return scm.StateError
+	case "notApplicable":
+		return scm.StateCanceled
+	default:
+		return scm.StateUnknown
+	}
+}
+
+func convertFromState(from scm.State) string {
+	switch from {
+	case scm.StatePending, scm.StateRunning:
+		return "pending"
+	case scm.StateSuccess:
+		return "succeeded"
+	case scm.StateFailure:
+		return "failed"
+	case scm.StateError:
+		return "error"
+	case scm.StateCanceled:
+		return "notApplicable"
+	default:
+		return "notSet"
+	}
+}
@@ -170,3 +170,127 @@ func TestRepositoryFind(t *testing.T) {
 	}
 
 }
+
+func TestStatusList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/commits/01768d964c03e97260af0bd8cd9e5cd1f9ac6356/statuses").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.ListStatus(context.Background(), "REPOID", "01768d964c03e97260af0bd8cd9e5cd1f9ac6356", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Status{}
+	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestStatusCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/commits/01768d964c03e97260af0bd8cd9e5cd1f9ac6356/statuses").
+		JSON(map[string]interface{}{
+			"state":       "succeeded",
+			"description": "build succeeded",
+			"targetUrl":   "https://drone.example.com/octocat/hello-world/2",
+			"context": map[string]string{
+				"name":  "drone",
+				"genre": "continuous-integration",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/status.json")
+
+	in := &scm.StatusInput{
+		State:  scm.StateSuccess,
+		Label:  "continuous-integration/drone",
+		Desc:   "build succeeded",
+		Target: "https://drone.example.com/octocat/hello-world/2",
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.CreateStatus(context.Background(), "REPOID", "01768d964c03e97260af0bd8cd9e5cd1f9ac6356", in)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Status)
+	raw, _ := ioutil.ReadFile("testdata/status.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestStatusList_PullRequest(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/19/statuses").
+		Reply(200).
+		Type("application/json").
+		File("testdata/statuses.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.ListStatus(context.Background(), "REPOID", "refs/pull/19/merge", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Status{}
+	raw, _ := ioutil.ReadFile("testdata/statuses.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryFindPerms(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/test_project").
+		Reply(200).
+		Type("application/json").
+		File("testdata/repo.json")
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/_apis/security/permissionevaluationbatch").
+		BodyString(`"token":"repoV2/d350c9c0-7749-4ff8-a78f-f9c1f0e56729/91f0d4cb-4c36-49a5-b28d-2d72da089c4d"`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/permissions.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Repositories.FindPerms(context.Background(), "test_project")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := &scm.Perm{Pull: true, Push: true, Admin: false}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -6,26 +6,259 @@ package azure
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// reviewService implements the review service using pull
+// request comment threads. A review is a thread anchored to
+// a file, and the review identifier is the thread id.
 type reviewService struct {
 	client *wrapper
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/get?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, id)
+	out := new(thread)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if !out.isReview() {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertReview(out), res, nil
 }
 
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/list?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	out := new(threadList)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	return convertReviewList(out.Value), res, err
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-threads/create?view=azure-devops-rest-6.0
+	if s.client.project == "" {
+		return nil, nil, ProjectRequiredError()
+	}
+	if input.InReplyTo != 0 {
+		return s.reply(ctx, repo, number, input)
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads?api-version=6.0",
+		s.client.owner, s.client.project, repo, number)
+	in := &threadInput{
+		Status: "active",
+		Comments: []*commentInput{
+			{Content: input.Body, CommentType: "text"},
+		},
+		ThreadContext: convertThreadContext(input),
+	}
+	out := new(thread)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	return convertReview(out), res, err
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-thread-comments/delete?view=azure-devops-rest-6.0
+	// azure does not delete threads. The thread is deleted
+	// once all of its comments are deleted.
+	if s.client.project == "" {
+		return nil, ProjectRequiredError()
+	}
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%d?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, id)
+	out := new(thread)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return res, err
+	}
+	// replies are deleted before the comments they reply to.
+	for i := len(out.Comments) - 1; i >= 0; i-- {
+		comment := out.Comments[i]
+		if comment.IsDeleted {
+			continue
+		}
+		endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%d/comments/%d?api-version=6.0",
+			s.client.owner, s.client.project, repo, number, id, comment.ID)
+		res, err = s.client.do(ctx, "DELETE", endpoint, nil, nil)
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// reply adds a comment to an existing thread.
+func (s *reviewService) reply(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/git/pull-request-thread-comments/create?view=azure-devops-rest-6.0
+	endpoint := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%d/threads/%d/comments?api-version=6.0",
+		s.client.owner, s.client.project, repo, number, input.InReplyTo)
+	in := &commentInput{Content: input.Body, CommentType: "text", ParentCommentID: 1}
+	out := new(threadComment)
+	res, err := s.client.do(ctx, "POST", endpoint, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	review := convertReviewComment(out)
+	review.ID = input.InReplyTo
+	review.Path = input.Path
+	review.Line = input.Line
+	return review, res, nil
+}
+
+type threadList struct {
+	Value []*thread `json:"value"`
+	Count int       `json:"count"`
+}
+
+type thread struct {
+	ID              int              `json:"id"`
+	Status          string           `json:"status"`
+	IsDeleted       bool             `json:"isDeleted"`
+	PublishedDate   time.Time        `json:"publishedDate"`
+	LastUpdatedDate time.Time        `json:"lastUpdatedDate"`
+	Comments        []*threadComment `json:"comments"`
+	ThreadContext   *threadContext   `json:"threadContext"`
+}
+
+type threadInput struct {
+	Status        string          `json:"status"`
+	Comments      []*commentInput `json:"comments"`
+	ThreadContext *threadContext  `json:"threadContext"`
+}
+
+type threadContext struct {
+	FilePath       string          `json:"filePath"`
+	LeftFileStart  *threadPosition `json:"leftFileStart,omitempty"`
+	LeftFileEnd    *threadPosition `json:"leftFileEnd,omitempty"`
+	RightFileStart *threadPosition `json:"rightFileStart,omitempty"`
+	RightFileEnd   *threadPosition `json:"rightFileEnd,omitempty"`
+}
+
+type threadPosition struct {
+	Line   int `json:"line"`
+	Offset int `json:"offset"`
+}
+
+type threadComment struct {
+	ID              int    `json:"id"`
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     string `json:"commentType"`
+	IsDeleted       bool   `json:"isDeleted"`
+	Author          struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+		UniqueName  string `json:"uniqueName"`
+		ImageURL    string `json:"imageUrl"`
+	} `json:"author"`
+	PublishedDate   time.Time `json:"publishedDate"`
+	LastUpdatedDate time.Time `json:"lastUpdatedDate"`
+}
+
+type commentInput struct {
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     string `json:"commentType"`
+}
+
+// isReview returns true if the thread is a file comment
+// thread created by a user.
+func (t *thread) isReview() bool {
+	if t.IsDeleted || t.ThreadContext == nil || len(t.Comments) == 0 {
+		return false
+	}
+	return t.Comments[0].CommentType != "system"
+}
+
+func convertThreadContext(from *scm.ReviewInput) *threadContext {
+	to := &threadContext{
+		FilePath: "/" + strings.TrimPrefix(from.Path, "/"),
+	}
+	if from.SubjectType == scm.SubjectTypeFile || from.Line == 0 {
+		return to
+	}
+	start, end := from.Line, from.Line
+	startSide := from.StartSide
+	if from.StartLine != 0 {
+		start = from.StartLine
+	}
+	if startSide == scm.SideUnspecified {
+		startSide = from.Side
+	}
+	// the offset is the 1-based character position. The end
+	// offset is set beyond the line length to select the
+	// whole line.
+	if startSide == scm.SideLeft {
+		to.LeftFileStart = &threadPosition{Line: start, Offset: 1}
+	} else {
+		to.RightFileStart = &threadPosition{Line: start, Offset: 1}
+	}
+	if from.Side == scm.SideLeft {
+		to.LeftFileEnd = &threadPosition{Line: end, Offset: 1 << 16}
+	} else {
+		to.RightFileEnd = &threadPosition{Line: end, Offset: 1 << 16}
+	}
+	return to
+}
+
+func convertReviewList(from []*thread) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from {
+		if v.isReview() {
+			to = append(to, convertReview(v))
+		}
+	}
+	return to
+}
+
+func convertReview(from *thread) *scm.Review {
+	to := &scm.Review{
+		ID:      from.ID,
+		Created: from.PublishedDate,
+		Updated: from.LastUpdatedDate,
+	}
+	if len(from.Comments) != 0 {
+		first := convertReviewComment(from.Comments[0])
+		to.Body = first.Body
+		to.Author = first.Author
+	}
+	if ctx := from.ThreadContext; ctx != nil {
+		to.Path = strings.TrimPrefix(ctx.FilePath, "/")
+		switch {
+		case ctx.RightFileEnd != nil:
+			to.Line = ctx.RightFileEnd.Line
+		case ctx.LeftFileEnd != nil:
+			to.Line = ctx.LeftFileEnd.Line
+		}
+	}
+	return to
+}
+
+func convertReviewComment(from *threadComment) *scm.Review {
+	return &scm.Review{
+		ID:   from.ID,
+		Body: from.Content,
+		Author: scm.User{
+			Login:  from.Author.UniqueName,
+			Name:   from.Author.DisplayName,
+			Avatar: from.Author.ImageURL,
+		},
+		Created: from.PublishedDate,
+		Updated: from.LastUpdatedDate,
+	}
 }
@@ -0,0 +1,180 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReviewFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/7").
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Find(context.Background(), "REPOID", 1, 7)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/thread.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads").
+		Reply(200).
+		Type("application/json").
+		File("testdata/threads.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.List(context.Background(), "REPOID", 1, scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/threads.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads").
+		JSON(map[string]interface{}{
+			"status": "active",
+			"comments": []interface{}{
+				map[string]interface{}{
+					"parentCommentId": 0,
+					"content":         "Is this needed?",
+					"commentType":     "text",
+				},
+			},
+			"threadContext": map[string]interface{}{
+				"filePath":       "/main.go",
+				"rightFileStart": map[string]int{"line": 12, "offset": 1},
+				"rightFileEnd":   map[string]int{"line": 14, "offset": 65536},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread.json")
+
+	input := &scm.ReviewInput{
+		Body:      "Is this needed?",
+		Path:      "main.go",
+		Line:      14,
+		StartLine: 12,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Create(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/thread.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewCreate_Reply(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Post("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/7/comments").
+		JSON(map[string]interface{}{
+			"parentCommentId": 1,
+			"content":         "Thanks",
+			"commentType":     "text",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread_comment.json")
+
+	input := &scm.ReviewInput{
+		Body:      "Thanks",
+		InReplyTo: 7,
+	}
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Reviews.Create(context.Background(), "REPOID", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got.ID != 7 || got.Body != "Thanks" {
+		t.Errorf("Want reply in thread 7, got thread %d with body %q", got.ID, got.Body)
+	}
+}
+
+func TestReviewDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/7").
+		Reply(200).
+		Type("application/json").
+		File("testdata/thread.json")
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/7/comments/2").
+		Reply(200)
+
+	gock.New("https:/dev.azure.com/").
+		Delete("/ORG/PROJ/_apis/git/repositories/REPOID/pullRequests/1/threads/7/comments/1").
+		Reply(200)
+
+	client := NewDefault("ORG", "PROJ")
+	res, err := client.Reviews.Delete(context.Background(), "REPOID", 1, 7)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 200; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+	if !gock.IsDone() {
+		t.Errorf("Expected all comments deleted")
+	}
+}
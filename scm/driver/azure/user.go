@@ -6,6 +6,9 @@ package azure
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -15,17 +18,132 @@ type userService struct {
 }
 
 func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error) {
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/core/?view=azure-devops-rest-6.0
+	// the connection data is undocumented, however it is
+	// available on both the hosted service and the server.
+	endpoint := fmt.Sprintf("%s/_apis/connectionData", s.client.owner)
+	out := new(connectionData)
+	res, err := s.client.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	// the authenticated user is omitted if the request is
+	// not authenticated.
+	if out.AuthenticatedUser == nil {
+		return nil, res, scm.ErrNotFound
+	}
+	return s.client.convertIdentity(out.AuthenticatedUser), res, nil
+}
+
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// https://docs.microsoft.com/en-us/rest/api/azure/devops/ims/identities/read-identities?view=azure-devops-rest-6.0
+	out, res, err := s.client.findIdentity(ctx, s.client.owner, login, false)
+	if err != nil {
+		return nil, res, err
+	}
+	return s.client.convertIdentity(out), res, nil
 }
 
 func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, error) {
-	return "", nil, scm.ErrNotSupported
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return "", res, err
+	}
+	return user.Email, res, nil
 }
 
-func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *userService) ListEmail(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	// azure accounts have a single email address, which
+	// is managed by the identity provider.
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	emails := []*scm.Email{}
+	if user.Email != "" {
+		emails = append(emails, &scm.Email{
+			Value:   user.Email,
+			Primary: true,
+		})
+	}
+	return emails, res, nil
+}
+
+// findIdentity returns the identity in the organization by
+// account name or email address. If membership is true, the
+// members of a group identity, including the members of
+// nested groups, are included.
+func (c *wrapper) findIdentity(ctx context.Context, owner, name string, membership bool) (*userIdentity, *scm.Response, error) {
+	params := url.Values{}
+	params.Set("searchFilter", "General")
+	params.Set("filterValue", name)
+	params.Set("queryMembership", "None")
+	if membership {
+		params.Set("queryMembership", "Expanded")
+	}
+	params.Set("api-version", "6.0")
+	endpoint := c.vssps(fmt.Sprintf("%s/_apis/identities?%s", owner, params.Encode()))
+	out := new(userIdentityList)
+	res, err := c.do(ctx, "GET", endpoint, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if len(out.Value) == 0 {
+		return nil, res, scm.ErrNotFound
+	}
+	return out.Value[0], res, nil
+}
+
+type connectionData struct {
+	AuthenticatedUser *userIdentity `json:"authenticatedUser"`
+	InstanceID        string        `json:"instanceId"`
+}
+
+type userIdentityList struct {
+	Count int             `json:"count"`
+	Value []*userIdentity `json:"value"`
+}
+
+type userIdentity struct {
+	ID                  string   `json:"id"`
+	Descriptor          string   `json:"descriptor"`
+	SubjectDescriptor   string   `json:"subjectDescriptor"`
+	ProviderDisplayName string   `json:"providerDisplayName"`
+	CustomDisplayName   string   `json:"customDisplayName"`
+	IsActive            bool     `json:"isActive"`
+	Members             []string `json:"members"`
+	Properties          struct {
+		Account userIdentityProperty `json:"Account"`
+		Mail    userIdentityProperty `json:"Mail"`
+	} `json:"properties"`
+}
+
+type userIdentityProperty struct {
+	Value string `json:"$value"`
+}
+
+func (c *wrapper) convertIdentity(from *userIdentity) *scm.User {
+	to := &scm.User{
+		ID:    from.ID,
+		Login: from.Properties.Account.Value,
+		Name:  from.ProviderDisplayName,
+		Email: from.Properties.Mail.Value,
+	}
+	if from.CustomDisplayName != "" {
+		to.Name = from.CustomDisplayName
+	}
+	// the account name of hosted service users is the
+	// email address, and the mail property is often empty.
+	if to.Email == "" && strings.Contains(to.Login, "@") {
+		to.Email = to.Login
+	}
+	if from.SubjectDescriptor != "" {
+		to.Avatar = fmt.Sprintf("%s%s/_apis/GraphProfile/MemberAvatars/%s",
+			c.BaseURL.String(), c.owner, from.SubjectDescriptor)
+	}
+	return to
 }
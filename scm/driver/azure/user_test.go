@@ -0,0 +1,147 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestUserFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/connectionData").
+		Reply(200).
+		Type("application/json").
+		File("testdata/connection_data.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Users.Find(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.User)
+	raw, _ := ioutil.ReadFile("testdata/user.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserFind_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/connectionData").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"instanceId":"00000000-0000-0000-0000-000000000000"}`)
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Users.Find(context.Background())
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserFindLogin(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://vssps.dev.azure.com/").
+		Get("/ORG/_apis/identities").
+		MatchParam("searchFilter", "General").
+		MatchParam("filterValue", "tp@harness.io").
+		Reply(200).
+		Type("application/json").
+		File("testdata/identities.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Users.FindLogin(context.Background(), "tp@harness.io")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.User)
+	raw, _ := ioutil.ReadFile("testdata/user.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestUserFindLogin_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://vssps.dev.azure.com/").
+		Get("/ORG/_apis/identities").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"count":0,"value":[]}`)
+
+	client := NewDefault("ORG", "PROJ")
+	_, _, err := client.Users.FindLogin(context.Background(), "octocat")
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
+	}
+}
+
+func TestUserFindEmail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/connectionData").
+		Reply(200).
+		Type("application/json").
+		File("testdata/connection_data.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Users.FindEmail(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if want := "tp@harness.io"; got != want {
+		t.Errorf("Want email %s, got %s", want, got)
+	}
+}
+
+func TestUserListEmail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https:/dev.azure.com/").
+		Get("/ORG/_apis/connectionData").
+		Reply(200).
+		Type("application/json").
+		File("testdata/connection_data.json")
+
+	client := NewDefault("ORG", "PROJ")
+	got, _, err := client.Users.ListEmail(context.Background(), scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Email{{Value: "tp@harness.io", Primary: true}}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
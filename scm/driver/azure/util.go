@@ -7,6 +7,7 @@ package azure
 import (
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -21,3 +22,42 @@ func encodeListOptions(opts scm.ListOptions) string {
 	}
 	return params.Encode()
 }
+
+// encodeTopSkip returns the $top and $skip query
+// parameters used by azure to page results.
+func encodeTopSkip(opts scm.ListOptions) string {
+	params := url.Values{}
+	if opts.Size != 0 {
+		params.Set("$top", strconv.Itoa(opts.Size))
+		if opts.Page > 1 {
+			params.Set("$skip", strconv.Itoa((opts.Page-1)*opts.Size))
+		}
+	}
+	return params.Encode()
+}
+
+func encodePullRequestListOptions(status string, opts scm.PullRequestListOptions) string {
+	params := url.Values{}
+	params.Set("searchCriteria.status", status)
+	if opts.Size != 0 {
+		params.Set("$top", strconv.Itoa(opts.Size))
+		if opts.Page > 1 {
+			params.Set("$skip", strconv.Itoa((opts.Page-1)*opts.Size))
+		}
+	}
+	return params.Encode()
+}
+
+// parsePullRef returns the pull request number from the
+// pull request reference, for example refs/pull/1/merge.
+func parsePullRef(ref string) (int, bool) {
+	parts := strings.Split(ref, "/")
+	if len(parts) < 3 || parts[0] != "refs" || parts[1] != "pull" {
+		return 0, false
+	}
+	number, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
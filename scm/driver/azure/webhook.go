@@ -10,6 +10,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -85,7 +86,16 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		dst.Action = getIssueCommentAction(src)
 		return dst, nil
 	default:
-		return nil, scm.ErrUnknownEvent
+		if !scm.RawHooksEnabled(req.Context()) {
+			return nil, scm.ErrUnknownEvent
+		}
+		src := new(rawHook)
+		err := json.Unmarshal(data, src)
+		if err != nil {
+			return nil, err
+		}
+		dst := convertRawHook(eventType, src, data)
+		return dst, nil
 	}
 }
 
@@ -99,6 +109,37 @@ func getIssueCommentAction(src *issueCommentPullRequestHook) scm.Action {
 	}
 }
 
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event: event,
+		Data:  data,
+		Repo: scm.Repository{
+			ID:        src.Resource.Repository.ID,
+			Namespace: src.Resource.Repository.Project.Name,
+			Name:      src.Resource.Repository.Name,
+			Branch:    scm.TrimRef(src.Resource.Repository.DefaultBranch),
+			Clone:     src.Resource.Repository.RemoteURL,
+			Link:      src.Resource.Repository.RemoteURL,
+		},
+	}
+	// the event type is in the format resource.action, eg
+	// git.pullrequest.created or build.complete
+	if i := strings.LastIndex(event, "."); i != -1 {
+		dst.Action = event[i+1:]
+	}
+	sender := src.Resource.CreatedBy
+	if sender.ID == "" {
+		sender = src.Resource.PushedBy
+	}
+	dst.Sender = scm.User{
+		Login:  sender.ID,
+		Name:   sender.DisplayName,
+		Email:  sender.UniqueName,
+		Avatar: sender.ImageURL,
+	}
+	return dst
+}
+
 func convertPushHook(src *pushHook) *scm.PushHook {
 	var commits []scm.Commit
 	for _, c := range src.Resource.Commits {
@@ -342,6 +383,32 @@ func convertIssueCommentHook(src *issueCommentPullRequestHook) *scm.IssueComment
 	return dst
 }
 
+// generic payload used to extract the repository and
+// sender from events without a dedicated hook type.
+type rawHook struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		Repository struct {
+			DefaultBranch string `json:"defaultBranch"`
+			ID            string `json:"id"`
+			Name          string `json:"name"`
+			Project       struct {
+				Name string `json:"name"`
+			} `json:"project"`
+			RemoteURL string `json:"remoteUrl"`
+		} `json:"repository"`
+		CreatedBy identity `json:"createdBy"`
+		PushedBy  identity `json:"pushedBy"`
+	} `json:"resource"`
+}
+
+type identity struct {
+	DisplayName string `json:"displayName"`
+	ID          string `json:"id"`
+	UniqueName  string `json:"uniqueName"`
+	ImageURL    string `json:"imageUrl"`
+}
+
 type pushHook struct {
 	CreatedDate     string `json:"createdDate"`
 	DetailedMessage struct {
@@ -123,3 +123,34 @@ func TestWebhooks(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
+
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	f = bytes.Replace(f, []byte(`"git.push"`), []byte(`"git.repo.created"`), 1)
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "git.repo.created"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Action, "created"; got != want {
+		t.Errorf("Want action %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "Fabrikam-Fiber-Git"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Name, "Jamal Hartnett"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+}
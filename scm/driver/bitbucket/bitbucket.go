@@ -43,6 +43,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Capabilities = scm.CopyCapabilities(capabilities)
 	return client.Client, nil
 }
 
@@ -53,6 +54,10 @@ func NewDefault() *scm.Client {
 	return client
 }
 
+// capabilities lists the capabilities supported by the
+// driver.
+var capabilities = map[scm.Capability]string{}
+
 // wraper wraps the Client to provide high level helper functions
 // for making http requests and unmarshaling the response.
 type wrapper struct {
@@ -141,6 +146,21 @@ func (c *wrapper) do(ctx context.Context, method, path string, in, out interface
 			req.Header = map[string][]string{
 				"Content-Type": {writer.FormDataContentType()},
 			}
+		case *downloadUpload:
+			var b bytes.Buffer
+			w := multipart.NewWriter(&b)
+			fw, err := w.CreateFormFile("files", content.Name)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := io.Copy(fw, content.Data); err != nil {
+				return nil, err
+			}
+			w.Close()
+			req.Body = &b
+			req.Header = map[string][]string{
+				"Content-Type": {w.FormDataContentType()},
+			}
 		default:
 			buf := new(bytes.Buffer)
 			json.NewEncoder(buf).Encode(in)
@@ -6,49 +6,111 @@ package bitbucket
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// ErrIssueTrackerDisabled is returned when the issue tracker
+// is not enabled for the repository.
+var ErrIssueTrackerDisabled = errors.New("bitbucket: the repository issue tracker is disabled")
+
 type issueService struct {
 	client *wrapper
 }
 
 func (s *issueService) Find(ctx context.Context, repo string, number int) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	out := new(issue)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	return convertIssue(out), res, nil
 }
 
 func (s *issueService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, index, id)
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	return convertIssueComment(out), res, nil
 }
 
 func (s *issueService) List(ctx context.Context, repo string, opts scm.IssueListOptions) ([]*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues?%s", repo, encodeIssueListOptions(opts))
+	out := new(issues)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	copyPagination(out.pagination, res)
+	return convertIssueList(out), res, nil
 }
 
 func (s *issueService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments?%s", repo, index, encodeListOptions(opts))
+	out := new(issueComments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	copyPagination(out.pagination, res)
+	return convertIssueCommentList(out), res, nil
 }
 
 func (s *issueService) Create(ctx context.Context, repo string, input *scm.IssueInput) (*scm.Issue, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues", repo)
+	in := &issueInput{Title: input.Title}
+	in.Content.Raw = input.Body
+	out := new(issue)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	return convertIssue(out), res, nil
 }
 
 func (s *issueService) CreateComment(ctx context.Context, repo string, number int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments", repo, number)
+	in := &prCommentInput{}
+	in.Content.Raw = input.Body
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	return convertIssueComment(out), res, nil
 }
 
-// EditComment is not supported: bitbucket issue tracker support isn't implemented in this driver.
 func (s *issueService) EditComment(ctx context.Context, repo string, number, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, number, id)
+	in := &prCommentInput{}
+	in.Content.Raw = input.Body
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "PUT", path, in, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	return convertIssueComment(out), res, nil
 }
 
 func (s *issueService) DeleteComment(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d/comments/%d", repo, number, id)
+	res, err := s.client.do(ctx, "DELETE", path, nil, nil)
+	return res, trackerError(err)
 }
 
 func (s *issueService) Close(ctx context.Context, repo string, number int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/issues/%d", repo, number)
+	in := &issueStateInput{State: "resolved"}
+	res, err := s.client.do(ctx, "PUT", path, in, nil)
+	return res, trackerError(err)
 }
 
 func (s *issueService) Lock(ctx context.Context, repo string, number int) (*scm.Response, error) {
@@ -69,3 +131,127 @@ func (s *issueService) AddReaction(context.Context, string, int, int, *scm.React
 func (s *issueService) DeleteReaction(context.Context, string, int, int, string) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
+
+// trackerError returns ErrIssueTrackerDisabled if the error
+// reports the repository has no issue tracker. Bitbucket
+// responds with a 404 in this case, which is otherwise
+// indistinguishable from a missing issue.
+func trackerError(err error) error {
+	if e, ok := err.(*Error); ok && e.StatusCode == 404 &&
+		strings.Contains(strings.ToLower(e.Data.Message), "no issue tracker") {
+		return ErrIssueTrackerDisabled
+	}
+	return err
+}
+
+// closedStates lists the issue states that are considered
+// closed. The remaining states are new, open and on hold.
+var closedStates = []string{"resolved", "invalid", "duplicate", "wontfix", "closed"}
+
+type issues struct {
+	pagination
+	Values []*issue `json:"values"`
+}
+
+type issue struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	Kind    string `json:"kind"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	Reporter user `json:"reporter"`
+	Links    struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+type issueInput struct {
+	Title   string `json:"title"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+}
+
+type issueStateInput struct {
+	State string `json:"state"`
+}
+
+type issueComments struct {
+	pagination
+	Values []*issueComment `json:"values"`
+}
+
+type issueComment struct {
+	ID      int `json:"id"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+	User      user      `json:"user"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+}
+
+func convertIssueList(from *issues) []*scm.Issue {
+	to := []*scm.Issue{}
+	for _, v := range from.Values {
+		to = append(to, convertIssue(v))
+	}
+	return to
+}
+
+func convertIssue(from *issue) *scm.Issue {
+	to := &scm.Issue{
+		Number:  from.ID,
+		Title:   from.Title,
+		Body:    from.Content.Raw,
+		Link:    from.Links.HTML.Href,
+		Closed:  isClosedState(from.State),
+		Author:  convertIssueUser(&from.Reporter),
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+	if from.Kind != "" {
+		to.Labels = []string{from.Kind}
+	}
+	return to
+}
+
+func convertIssueCommentList(from *issueComments) []*scm.Comment {
+	to := []*scm.Comment{}
+	for _, v := range from.Values {
+		to = append(to, convertIssueComment(v))
+	}
+	return to
+}
+
+func convertIssueComment(from *issueComment) *scm.Comment {
+	return &scm.Comment{
+		ID:      from.ID,
+		Body:    from.Content.Raw,
+		Author:  convertIssueUser(&from.User),
+		Created: from.CreatedOn,
+		Updated: from.UpdatedOn,
+	}
+}
+
+func convertIssueUser(from *user) scm.User {
+	return scm.User{
+		ID:     from.UUID,
+		Login:  from.Nickname,
+		Name:   from.DisplayName,
+		Avatar: from.Links.Avatar.Href,
+	}
+}
+
+func isClosedState(state string) bool {
+	for _, v := range closedStates {
+		if v == state {
+			return true
+		}
+	}
+	return false
+}
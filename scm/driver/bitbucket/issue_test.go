@@ -6,64 +6,299 @@ package bitbucket
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestIssueFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.Find(context.Background(), "", 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/issues/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.Find(context.Background(), "brianharness/test", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueFind_TrackerDisabled(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/issues/1").
+		Reply(404).
+		Type("application/json").
+		File("testdata/issue_tracker_disabled.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Issues.Find(context.Background(), "brianharness/test", 1)
+	if err != ErrIssueTrackerDisabled {
+		t.Errorf("Want ErrIssueTrackerDisabled, got %v", err)
+	}
+}
+
+func TestIssueFind_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/issues/99").
+		Reply(404).
+		Type("application/json").
+		BodyString(`{"type": "error", "error": {"message": "Resource not found"}}`)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Issues.Find(context.Background(), "brianharness/test", 99)
+	if err == nil || err == ErrIssueTrackerDisabled {
+		t.Errorf("Want not found error, got %v", err)
 	}
 }
 
 func TestIssueCommentFind(t *testing.T) {
-	_, _, err := NewDefault().Issues.FindComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/issues/1/comments/66210483").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.FindComment(context.Background(), "brianharness/test", 1, 66210483)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueList(t *testing.T) {
-	_, _, err := NewDefault().Issues.List(context.Background(), "", scm.IssueListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/issues").
+		MatchParam("pagelen", "10").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issues.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Issues.List(context.Background(), "brianharness/test", scm.IssueListOptions{Page: 1, Size: 10, Open: true, Closed: true})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Issue{}
+	raw, _ := ioutil.ReadFile("testdata/issues.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
+	}
+}
+
+func TestIssueList_Open(t *testing.T) {
+	defer gock.Off()
+
+	// the default options and the open option both list the
+	// open issues.
+	for _, opts := range []scm.IssueListOptions{{}, {Open: true}} {
+		gock.New("https://api.bitbucket.org").
+			Get("/2.0/repositories/brianharness/test/issues").
+			MatchParam("q", `^state!="resolved" AND state!="invalid" AND state!="duplicate" AND state!="wontfix" AND state!="closed"$`).
+			Reply(200).
+			Type("application/json").
+			File("testdata/issues.json")
+
+		client, _ := New("https://api.bitbucket.org")
+		_, _, err := client.Issues.List(context.Background(), "brianharness/test", opts)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
 	}
 }
 
 func TestIssueListComments(t *testing.T) {
-	_, _, err := NewDefault().Issues.ListComments(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/issues/1/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comments.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.ListComments(context.Background(), "brianharness/test", 1, scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Comment{}
+	raw, _ := ioutil.ReadFile("testdata/issue_comments.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCreate(t *testing.T) {
-	_, _, err := NewDefault().Issues.Create(context.Background(), "", &scm.IssueInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brianharness/test/issues").
+		JSON(map[string]interface{}{
+			"title":   "Build fails on windows",
+			"content": map[string]string{"raw": "The build fails with a path error."},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.Create(context.Background(), "brianharness/test", &scm.IssueInput{Title: "Build fails on windows", Body: "The build fails with a path error."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Issue)
+	raw, _ := ioutil.ReadFile("testdata/issue.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCreateComment(t *testing.T) {
-	_, _, err := NewDefault().Issues.CreateComment(context.Background(), "", 0, &scm.CommentInput{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/brianharness/test/issues/1/comments").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Confirmed on windows 11."},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.CreateComment(context.Background(), "brianharness/test", 1, &scm.CommentInput{Body: "Confirmed on windows 11."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueEditComment(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/brianharness/test/issues/1/comments/66210483").
+		JSON(map[string]interface{}{
+			"content": map[string]string{"raw": "Confirmed on windows 11."},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue_comment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Issues.EditComment(context.Background(), "brianharness/test", 1, 66210483, &scm.CommentInput{Body: "Confirmed on windows 11."})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/issue_comment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestIssueCommentDelete(t *testing.T) {
-	_, err := NewDefault().Issues.DeleteComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/brianharness/test/issues/1/comments/66210483").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Issues.DeleteComment(context.Background(), "brianharness/test", 1, 66210483)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
 func TestIssueClose(t *testing.T) {
-	_, err := NewDefault().Issues.Close(context.Background(), "", 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Put("/2.0/repositories/brianharness/test/issues/1").
+		JSON(map[string]string{"state": "resolved"}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/issue.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Issues.Close(context.Background(), "brianharness/test", 1)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
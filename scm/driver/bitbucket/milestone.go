@@ -2,6 +2,7 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -11,21 +12,72 @@ type milestoneService struct {
 }
 
 func (s *milestoneService) Find(ctx context.Context, repo string, id int) (*scm.Milestone, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/milestones/%d", repo, id)
+	out := new(milestone)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	return convertMilestone(out), res, nil
 }
 
+// List returns the repository milestones. Bitbucket
+// milestones have no state, so the open and closed options
+// are ignored.
 func (s *milestoneService) List(ctx context.Context, repo string, opts scm.MilestoneListOptions) ([]*scm.Milestone, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/milestones?%s", repo, encodeMilestoneListOptions(opts))
+	out := new(milestones)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, trackerError(err)
+	}
+	copyPagination(out.pagination, res)
+	return convertMilestoneList(out), res, nil
 }
 
+// Create is not supported: the 2.0 api provides read-only
+// access to milestones, which are managed in the issue
+// tracker settings.
 func (s *milestoneService) Create(ctx context.Context, repo string, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// Delete is not supported: see Create.
 func (s *milestoneService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// Update is not supported: see Create.
 func (s *milestoneService) Update(ctx context.Context, repo string, id int, input *scm.MilestoneInput) (*scm.Milestone, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
+
+type milestones struct {
+	pagination
+	Values []*milestone `json:"values"`
+}
+
+type milestone struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Links struct {
+		Self link `json:"self"`
+	} `json:"links"`
+}
+
+func convertMilestoneList(from *milestones) []*scm.Milestone {
+	to := []*scm.Milestone{}
+	for _, v := range from.Values {
+		to = append(to, convertMilestone(v))
+	}
+	return to
+}
+
+func convertMilestone(from *milestone) *scm.Milestone {
+	return &scm.Milestone{
+		Number: from.ID,
+		ID:     from.ID,
+		Title:  from.Name,
+		Link:   from.Links.Self.Href,
+	}
+}
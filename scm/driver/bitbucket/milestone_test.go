@@ -0,0 +1,108 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestMilestoneFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/milestones/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/milestone.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Milestones.Find(context.Background(), "brianharness/test", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Milestone)
+	raw, _ := ioutil.ReadFile("testdata/milestone.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMilestoneList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/milestones").
+		MatchParam("pagelen", "10").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/milestones.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Milestones.List(context.Background(), "brianharness/test", scm.MilestoneListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Milestone{}
+	raw, _ := ioutil.ReadFile("testdata/milestones.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestMilestoneList_TrackerDisabled(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/milestones").
+		Reply(404).
+		Type("application/json").
+		File("testdata/issue_tracker_disabled.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Milestones.List(context.Background(), "brianharness/test", scm.MilestoneListOptions{})
+	if err != ErrIssueTrackerDisabled {
+		t.Errorf("Want ErrIssueTrackerDisabled, got %v", err)
+	}
+}
+
+func TestMilestoneCreate(t *testing.T) {
+	_, _, err := NewDefault().Milestones.Create(context.Background(), "brianharness/test", &scm.MilestoneInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMilestoneUpdate(t *testing.T) {
+	_, _, err := NewDefault().Milestones.Update(context.Background(), "brianharness/test", 1, &scm.MilestoneInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestMilestoneDelete(t *testing.T) {
+	_, err := NewDefault().Milestones.Delete(context.Background(), "brianharness/test", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
@@ -235,9 +235,27 @@ func TestPullListCommits(t *testing.T) {
 }
 
 func TestPullRequestCommentFind(t *testing.T) {
-	_, _, err := NewDefault().PullRequests.FindComment(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/atlaskit/pullrequests/12/comments/419169807").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prcomment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.PullRequests.FindComment(context.Background(), "atlassian/atlaskit", 12, 419169807)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := new(scm.Comment)
+	raw, _ := ioutil.ReadFile("testdata/prcomment.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
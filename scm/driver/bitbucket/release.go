@@ -2,42 +2,292 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// releaseService emulates releases, which bitbucket does
+// not support, using annotated tags. The release title and
+// description are stored in the tag message, and release
+// artifacts are stored in the repository Downloads. A tag
+// has no numeric id, so releases are identified by tag.
 type releaseService struct {
 	client *wrapper
 }
 
+// Find is not supported: releases are identified by tag.
 func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *releaseService) FindByTag(ctx context.Context, repo string, tag string) (*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, url.PathEscape(tag))
+	out := new(releaseTag)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertRelease(out), res, nil
 }
 
+// List returns the releases, most recent first. A tag has
+// no state, so the open and closed options are ignored.
 func (s *releaseService) List(ctx context.Context, repo string, opts scm.ReleaseListOptions) ([]*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags?%s", repo, encodeReleaseListOptions(opts))
+	out := new(releaseTags)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	copyPagination(out.pagination, res)
+	return convertReleaseList(out), res, nil
 }
 
+// Create creates the release tag. A tag is always published,
+// so draft releases are not supported.
 func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	if input.Draft {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags", repo)
+	in := &releaseTagInput{
+		Name:    input.Tag,
+		Message: releaseMessage(input.Title, input.Description),
+	}
+	in.Target.Hash = input.Commitish
+	out := new(releaseTag)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertRelease(out), res, nil
 }
 
+// Delete is not supported: releases are identified by tag.
 func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
+// DeleteByTag deletes the release tag. The release artifacts
+// are not deleted.
 func (s *releaseService) DeleteByTag(ctx context.Context, repo string, tag string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/refs/tags/%s", repo, url.PathEscape(tag))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Update is not supported: releases are identified by tag.
 func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// UpdateByTag updates the release. A tag cannot be modified,
+// so the tag is deleted and created again. If the tag is
+// renamed, the new tag is created before the old tag is
+// deleted. Empty input fields keep the existing value.
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	if input.Draft {
+		return nil, nil, scm.ErrNotSupported
+	}
+	release, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	update := *input
+	if update.Tag == "" {
+		update.Tag = release.Tag
+	}
+	if update.Title == "" {
+		update.Title = release.Title
+	}
+	if update.Description == "" {
+		update.Description = release.Description
+	}
+	if update.Commitish == "" {
+		update.Commitish = release.Commitish
+	}
+	if update.Tag != tag {
+		// the new tag is created before the old tag is
+		// deleted, so the release is not lost if the tag
+		// cannot be created.
+		out, res, err := s.Create(ctx, repo, &update)
+		if err != nil {
+			return nil, res, err
+		}
+		res, err = s.DeleteByTag(ctx, repo, tag)
+		if err != nil {
+			return nil, res, fmt.Errorf("release tag %s was created, however tag %s could not be deleted: %w", update.Tag, tag, err)
+		}
+		return out, res, nil
+	}
+	res, err = s.DeleteByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	out, res, err := s.Create(ctx, repo, &update)
+	if err != nil {
+		return nil, res, fmt.Errorf("release tag %s was deleted and could not be created again: %w", tag, err)
+	}
+	return out, res, nil
+}
+
+// ReleaseAsset is a release artifact stored in the
+// repository Downloads.
+type ReleaseAsset struct {
+	Name    string
+	Link    string
+	Size    int64
+	Created time.Time
+}
+
+// UploadReleaseAsset uploads a release artifact to the
+// repository Downloads. The download is named after the
+// release tag and the artifact name, separated by a tilde,
+// which cannot appear in a tag name.
+func UploadReleaseAsset(ctx context.Context, client *scm.Client, repo, tag, name string, data io.Reader) (*scm.Response, error) {
+	s, ok := client.Releases.(*releaseService)
+	if !ok {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/downloads", repo)
+	in := &downloadUpload{
+		Name: assetPrefix(tag) + name,
+		Data: data,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
+}
+
+// ListReleaseAssets returns the release artifacts uploaded
+// with UploadReleaseAsset. The downloads are filtered after
+// pagination, so a page may contain fewer artifacts than the
+// page size.
+func ListReleaseAssets(ctx context.Context, client *scm.Client, repo, tag string, opts scm.ListOptions) ([]*ReleaseAsset, *scm.Response, error) {
+	s, ok := client.Releases.(*releaseService)
+	if !ok {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("2.0/repositories/%s/downloads?%s", repo, encodeListOptions(opts))
+	out := new(downloads)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	copyPagination(out.pagination, res)
+	return convertReleaseAssetList(out, tag), res, nil
+}
+
+type releaseTags struct {
+	pagination
+	Values []*releaseTag `json:"values"`
+}
+
+type releaseTag struct {
+	Name    string    `json:"name"`
+	Message string    `json:"message"`
+	Date    time.Time `json:"date"`
+	Target  struct {
+		Hash string    `json:"hash"`
+		Date time.Time `json:"date"`
+	} `json:"target"`
+	Links struct {
+		HTML link `json:"html"`
+	} `json:"links"`
+}
+
+type releaseTagInput struct {
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+	Target  struct {
+		Hash string `json:"hash"`
+	} `json:"target"`
+}
+
+type downloads struct {
+	pagination
+	Values []*download `json:"values"`
+}
+
+type download struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	CreatedOn time.Time `json:"created_on"`
+	Links     struct {
+		Self link `json:"self"`
+	} `json:"links"`
+}
+
+// downloadUpload is uploaded as a multipart file.
+type downloadUpload struct {
+	Name string
+	Data io.Reader
+}
+
+// releaseMessage returns the tag message, which is the
+// release title followed by the description.
+func releaseMessage(title, description string) string {
+	if description == "" {
+		return title
+	}
+	return title + "\n\n" + description
+}
+
+// assetPrefix returns the download name prefix of the
+// release artifacts. The tilde is not valid in a git ref
+// name, so the prefix of one tag cannot match the artifacts
+// of another tag.
+func assetPrefix(tag string) string {
+	return tag + "~"
+}
+
+func convertReleaseList(from *releaseTags) []*scm.Release {
+	to := []*scm.Release{}
+	for _, v := range from.Values {
+		to = append(to, convertRelease(v))
+	}
+	return to
+}
+
+func convertRelease(from *releaseTag) *scm.Release {
+	title, description := from.Name, ""
+	if message := strings.TrimSpace(from.Message); message != "" {
+		parts := strings.SplitN(message, "\n", 2)
+		title = strings.TrimSpace(parts[0])
+		if len(parts) == 2 {
+			description = strings.TrimSpace(parts[1])
+		}
+	}
+	created := from.Date
+	if created.IsZero() {
+		created = from.Target.Date
+	}
+	return &scm.Release{
+		Title:       title,
+		Description: description,
+		Link:        from.Links.HTML.Href,
+		Tag:         from.Name,
+		Commitish:   from.Target.Hash,
+		Created:     created,
+		Published:   created,
+	}
+}
+
+func convertReleaseAssetList(from *downloads, tag string) []*ReleaseAsset {
+	to := []*ReleaseAsset{}
+	prefix := assetPrefix(tag)
+	for _, v := range from.Values {
+		if !strings.HasPrefix(v.Name, prefix) {
+			continue
+		}
+		to = append(to, &ReleaseAsset{
+			Name:    strings.TrimPrefix(v.Name, prefix),
+			Link:    v.Links.Self.Href,
+			Size:    v.Size,
+			Created: v.CreatedOn,
+		})
+	}
+	return to
 }
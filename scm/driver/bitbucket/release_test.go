@@ -0,0 +1,341 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	_, _, err := NewDefault().Releases.Find(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseFindByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.FindByTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/tags").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "1").
+		MatchParam("sort", "-target.date").
+		Reply(200).
+		Type("application/json").
+		File("testdata/releases.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Releases.List(context.Background(), "atlassian/stash-example-plugin", scm.ReleaseListOptions{Page: 1, Size: 1})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Page", testPage(res))
+}
+
+func TestReleaseCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/tags").
+		JSON(map[string]interface{}{
+			"name":    "v1.0.0",
+			"message": "First release\n\nDescription of the release",
+			"target": map[string]string{
+				"hash": "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/release.json")
+
+	input := &scm.ReleaseInput{
+		Title:       "First release",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.Create(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseCreate_Draft(t *testing.T) {
+	input := &scm.ReleaseInput{
+		Tag:   "v1.0.0",
+		Draft: true,
+	}
+	_, _, err := NewDefault().Releases.Create(context.Background(), "atlassian/stash-example-plugin", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseDelete(t *testing.T) {
+	_, err := NewDefault().Releases.Delete(context.Background(), "atlassian/stash-example-plugin", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseDeleteByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Releases.DeleteByTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReleaseUpdate(t *testing.T) {
+	_, _, err := NewDefault().Releases.Update(context.Background(), "atlassian/stash-example-plugin", 1, &scm.ReleaseInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseUpdateByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(204)
+
+	// the title and commit of the existing release are kept.
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/tags").
+		JSON(map[string]interface{}{
+			"name":    "v1.0.0",
+			"message": "First release\n\nDescription of the release",
+			"target": map[string]string{
+				"hash": "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/release.json")
+
+	input := &scm.ReleaseInput{
+		Description: "Description of the release",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.UpdateByTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestReleaseUpdateByTag_Rename(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0-rc1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	// the new tag is created before the old tag is deleted.
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/tags").
+		JSON(map[string]interface{}{
+			"name":    "v1.0.0",
+			"message": "First release\n\nDescription of the release",
+			"target": map[string]string{
+				"hash": "a6e5e7d797edf751cbd839d6bd4aef86c941eec9",
+			},
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0-rc1").
+		Reply(204)
+
+	input := &scm.ReleaseInput{
+		Tag: "v1.0.0",
+	}
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Releases.UpdateByTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0-rc1", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestReleaseUpdateByTag_Recreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/atlassian/stash-example-plugin/refs/tags/v1.0.0").
+		Reply(204)
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/refs/tags").
+		Reply(500)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Releases.UpdateByTag(context.Background(), "atlassian/stash-example-plugin", "v1.0.0", &scm.ReleaseInput{})
+	if err == nil || !strings.Contains(err.Error(), "v1.0.0 was deleted") {
+		t.Errorf("Expect deleted tag error, got %v", err)
+	}
+}
+
+func TestUploadReleaseAsset(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Post("/2.0/repositories/atlassian/stash-example-plugin/downloads").
+		MatchHeader("Content-Type", "^multipart/form-data").
+		BodyString(`name="files"; filename="v1.0.0~app.zip"`).
+		Reply(201)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := UploadReleaseAsset(context.Background(), client, "atlassian/stash-example-plugin", "v1.0.0", "app.zip", strings.NewReader("data"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListReleaseAssets(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/atlassian/stash-example-plugin/downloads").
+		MatchParam("page", "1").
+		MatchParam("pagelen", "10").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release_assets.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := ListReleaseAssets(context.Background(), client, "atlassian/stash-example-plugin", "v1.0.0", scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*ReleaseAsset{}
+	raw, _ := ioutil.ReadFile("testdata/release_assets.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestListReleaseAssets_NotSupported(t *testing.T) {
+	client := &scm.Client{}
+	_, _, err := ListReleaseAssets(context.Background(), client, "atlassian/stash-example-plugin", "v1.0.0", scm.ListOptions{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
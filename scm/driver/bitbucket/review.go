@@ -30,12 +30,34 @@ type reviewService struct {
 	client *wrapper
 }
 
+// Find returns the inline pull request comment. A comment
+// that is not anchored to a file is not a review comment, and
+// ErrNotFound is returned.
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	out := new(prComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	if out.Deleted || out.Inline.Path == "" {
+		return nil, res, scm.ErrNotFound
+	}
+	return convertReviewComment(out), res, nil
 }
 
+// List returns the inline pull request comments. The comments
+// are filtered after pagination, so a page may contain fewer
+// reviews than the page size.
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments?%s", repo, number, encodeListOptions(opts))
+	out := new(prComments)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	copyPagination(out.pagination, res)
+	return convertReviewCommentList(out), res, nil
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
@@ -66,6 +88,17 @@ func (s *reviewService) Create(ctx context.Context, repo string, number int, inp
 	return convertReviewComment(out), res, err
 }
 
+func convertReviewCommentList(from *prComments) []*scm.Review {
+	to := []*scm.Review{}
+	for _, v := range from.Values {
+		if v.Deleted || v.Inline.Path == "" {
+			continue
+		}
+		to = append(to, convertReviewComment(v))
+	}
+	return to
+}
+
 func convertReviewComment(from *prComment) *scm.Review {
 	line := from.Inline.To
 	if line == 0 {
@@ -89,5 +122,6 @@ func convertReviewComment(from *prComment) *scm.Review {
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/comments/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
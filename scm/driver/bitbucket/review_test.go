@@ -17,23 +17,90 @@ import (
 )
 
 func TestReviewFind(t *testing.T) {
-	_, _, err := NewDefault().Reviews.Find(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/pullrequests/3/comments/419169807").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Reviews.Find(context.Background(), "brianharness/test", 3, 419169807)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewFind_NotInline(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/pullrequests/3/comments/419169807").
+		Reply(200).
+		Type("application/json").
+		File("testdata/prcomment.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	_, _, err := client.Reviews.Find(context.Background(), "brianharness/test", 3, 419169807)
+	if err != scm.ErrNotFound {
+		t.Errorf("Want ErrNotFound, got %v", err)
 	}
 }
 
 func TestReviewList(t *testing.T) {
-	_, _, err := NewDefault().Reviews.List(context.Background(), "", 0, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/repositories/brianharness/test/pullrequests/3/comments").
+		MatchParam("pagelen", "10").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviews.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, res, err := client.Reviews.List(context.Background(), "brianharness/test", 3, scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if got, want := res.Page.Next, 2; got != want {
+		t.Errorf("Want next page %d, got %d", want, got)
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
-	_, err := NewDefault().Reviews.Delete(context.Background(), "", 0, 0)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Delete("/2.0/repositories/brianharness/test/pullrequests/3/comments/419169807").
+		Reply(204)
+
+	client, _ := New("https://api.bitbucket.org")
+	_, err := client.Reviews.Delete(context.Background(), "brianharness/test", 3, 419169807)
+	if err != nil {
+		t.Error(err)
 	}
 }
 
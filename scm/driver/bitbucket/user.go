@@ -21,6 +21,10 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("2.0/users/%s", login)
 	out := new(user)
@@ -34,8 +38,15 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
     return convertEmailList(out), res, err
 }
 
-func (s *userService) ListEmail(context.Context, scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *userService) ListEmail(ctx context.Context, opts scm.ListOptions) ([]*scm.Email, *scm.Response, error) {
+	path := fmt.Sprintf("2.0/user/emails?%s", encodeListOptions(opts))
+	out := new(emails)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	copyPagination(out.pagination, res)
+	return convertEmails(out), res, nil
 }
 
 func convertEmailList(from *emails) string {
@@ -65,12 +76,26 @@ type user struct {
 }
 
 type email struct {
-    Email string `json:"email"`
-    IsPrimary bool `json:"is_primary"`
+	Email       string `json:"email"`
+	IsPrimary   bool   `json:"is_primary"`
+	IsConfirmed bool   `json:"is_confirmed"`
 }
 
 type emails struct {
-    Values []*email `json:"values"`
+	pagination
+	Values []*email `json:"values"`
+}
+
+func convertEmails(from *emails) []*scm.Email {
+	to := []*scm.Email{}
+	for _, v := range from.Values {
+		to = append(to, &scm.Email{
+			Value:    v.Email,
+			Primary:  v.IsPrimary,
+			Verified: v.IsConfirmed,
+		})
+	}
+	return to
 }
 
 func convertUser(from *user) *scm.User {
@@ -88,3 +88,31 @@ func TestUserFindEmail(t *testing.T) {
 		t.Log(diff)
 	}
 }
+
+func TestUserListEmail(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.bitbucket.org").
+		Get("/2.0/user/emails").
+		MatchParam("pagelen", "10").
+		MatchParam("page", "1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user_emails.json")
+
+	client, _ := New("https://api.bitbucket.org")
+	got, _, err := client.Users.ListEmail(context.Background(), scm.ListOptions{Page: 1, Size: 10})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Email{}
+	raw, _ := ioutil.ReadFile("testdata/user_emails.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -114,11 +114,49 @@ func encodeIssueListOptions(opts scm.IssueListOptions) string {
 	if opts.Size != 0 {
 		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
-	if opts.Open && opts.Closed {
-		params.Set("state", "all")
-	} else if opts.Closed {
-		params.Set("state", "closed")
+	// the issue state is filtered using the query language,
+	// since bitbucket has multiple open and closed states. The
+	// query language has no NOT operator, so the open issues
+	// are those that match none of the closed states.
+	if !(opts.Open && opts.Closed) {
+		var states []string
+		for _, state := range closedStates {
+			if opts.Closed {
+				states = append(states, fmt.Sprintf("state=%q", state))
+			} else {
+				states = append(states, fmt.Sprintf("state!=%q", state))
+			}
+		}
+		if opts.Closed {
+			params.Set("q", strings.Join(states, " OR "))
+		} else {
+			params.Set("q", strings.Join(states, " AND "))
+		}
+	}
+	return params.Encode()
+}
+
+func encodeMilestoneListOptions(opts scm.MilestoneListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
+	params := url.Values{}
+	if opts.Page != 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.Size != 0 {
+		params.Set("pagelen", strconv.Itoa(opts.Size))
 	}
+	// the most recent releases are listed first.
+	params.Set("sort", "-target.date")
 	return params.Encode()
 }
 
@@ -44,13 +44,39 @@ func Test_encodeIssueListOptions(t *testing.T) {
 		Open:   true,
 		Closed: true,
 	}
-	want := "page=10&pagelen=30&state=all"
+	want := "page=10&pagelen=30"
 	got := encodeIssueListOptions(opts)
 	if got != want {
 		t.Errorf("Want encoded issue list options %q, got %q", want, got)
 	}
 }
 
+func Test_encodeIssueListOptions_State(t *testing.T) {
+	tests := []struct {
+		opts scm.IssueListOptions
+		want string
+	}{
+		{
+			opts: scm.IssueListOptions{},
+			want: `state!="resolved" AND state!="invalid" AND state!="duplicate" AND state!="wontfix" AND state!="closed"`,
+		},
+		{
+			opts: scm.IssueListOptions{Open: true},
+			want: `state!="resolved" AND state!="invalid" AND state!="duplicate" AND state!="wontfix" AND state!="closed"`,
+		},
+		{
+			opts: scm.IssueListOptions{Closed: true},
+			want: `state="resolved" OR state="invalid" OR state="duplicate" OR state="wontfix" OR state="closed"`,
+		},
+	}
+	for _, test := range tests {
+		params, _ := url.ParseQuery(encodeIssueListOptions(test.opts))
+		if got := params.Get("q"); got != test.want {
+			t.Errorf("Want issue query %q, got %q", test.want, got)
+		}
+	}
+}
+
 func Test_encodePullRequestListOptions(t *testing.T) {
 	t.Parallel()
 	opts := scm.PullRequestListOptions{
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -75,6 +76,9 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	case "repo:commit_status_updated", "repo:commit_status_created":
 		hook, err = s.parsePipelineHook(data)
 	}
+	if hook == nil && err == nil && scm.RawHooksEnabled(req.Context()) {
+		hook, err = s.parseRawHook(req.Header.Get("x-event-key"), data)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +155,15 @@ func (s *webhookService) parsePipelineHook(data []byte) (*scm.PipelineHook, erro
 	return convertBitbucketHook(dst), err
 }
 
+func (s *webhookService) parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	dst := new(rawHook)
+	err := json.Unmarshal(data, dst)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawHook(event, dst, data), nil
+}
+
 //
 // native data structures
 //
@@ -382,6 +395,13 @@ type (
 		Actor       webhookActor      `json:"actor"`
 	}
 
+	// generic payload used to extract the repository and
+	// sender from events without a dedicated hook type.
+	rawHook struct {
+		Repository *webhookRepository `json:"repository"`
+		Actor      *webhookActor      `json:"actor"`
+	}
+
 	webhookRepository struct {
 		Scm   string `json:"scm"`
 		Name  string `json:"name"`
@@ -999,6 +1019,39 @@ func convertPrCommentHook(src *prCommentHook) *scm.IssueCommentHook {
 	return &dst
 }
 
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event: event,
+		Data:  data,
+	}
+	// the event key is in the format resource:action, eg
+	// repo:fork or issue:comment_created
+	if i := strings.LastIndex(event, ":"); i != -1 {
+		dst.Action = event[i+1:]
+	}
+	if src.Repository != nil {
+		namespace, name := scm.Split(src.Repository.FullName)
+		dst.Repo = scm.Repository{
+			ID:        src.Repository.UUID,
+			Namespace: namespace,
+			Name:      name,
+			Private:   src.Repository.IsPrivate,
+			Clone:     fmt.Sprintf("https://bitbucket.org/%s.git", src.Repository.FullName),
+			CloneSSH:  fmt.Sprintf("git@bitbucket.org:%s.git", src.Repository.FullName),
+			Link:      src.Repository.Links.HTML.Href,
+		}
+	}
+	if src.Actor != nil {
+		dst.Sender = scm.User{
+			ID:     src.Actor.UUID,
+			Login:  src.Actor.Username,
+			Name:   src.Actor.DisplayName,
+			Avatar: src.Actor.Links.Avatar.Href,
+		}
+	}
+	return dst
+}
+
 func convertBitbucketHook(src *pipelineHook) *scm.PipelineHook {
 	if src.CommitStatus.Type == "" || src.CommitStatus.Type != "build" {
 		return nil
@@ -243,3 +243,49 @@ func TestWebhookValidated(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
+
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", bytes.NewBuffer(f))
+	r.Header.Set("x-event-key", "repo:updated")
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	s := new(webhookService)
+	o, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "repo:updated"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Action, "updated"; got != want {
+		t.Errorf("Want action %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Namespace+"/"+hook.Repo.Name, "brydzewski/foo"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "brydzewski"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+}
+
+func TestWebhookRawHookDisabled(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", bytes.NewBuffer(f))
+	r.Header.Set("x-event-key", "repo:updated")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Error(err)
+	}
+	if o != nil {
+		t.Errorf("Expect nil hook for unknown event, got %T", o)
+	}
+}
@@ -27,15 +27,45 @@ func (s *contentService) Find(ctx context.Context, repo, path, ref string) (*scm
 }
 
 func (s *contentService) Create(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentCreateUpdate{
+		Message:   params.Message,
+		Branch:    params.Branch,
+		Content:   params.Data,
+		Author:    convertIdentity(params.Signature),
+		Committer: convertIdentity(params.Signature),
+	}
+	res, err := s.client.do(ctx, "POST", endpoint, in, nil)
+	return res, err
 }
 
 func (s *contentService) Update(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentCreateUpdate{
+		Message: params.Message,
+		Branch:  params.Branch,
+		Content: params.Data,
+		// NB the sha passed to gitea rest api is the blob sha, not the commit sha
+		Sha:       params.BlobID,
+		Author:    convertIdentity(params.Signature),
+		Committer: convertIdentity(params.Signature),
+	}
+	res, err := s.client.do(ctx, "PUT", endpoint, in, nil)
+	return res, err
 }
 
 func (s *contentService) Delete(ctx context.Context, repo, path string, params *scm.ContentParams) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	endpoint := fmt.Sprintf("api/v1/repos/%s/contents/%s", repo, path)
+	in := &contentCreateUpdate{
+		Message: params.Message,
+		Branch:  params.Branch,
+		// NB the sha passed to gitea rest api is the blob sha, not the commit sha
+		Sha:       params.BlobID,
+		Author:    convertIdentity(params.Signature),
+		Committer: convertIdentity(params.Signature),
+	}
+	res, err := s.client.do(ctx, "DELETE", endpoint, in, nil)
+	return res, err
 }
 
 func (s *contentService) List(ctx context.Context, repo, path, ref string, _ scm.ListOptions) ([]*scm.ContentInfo, *scm.Response, error) {
@@ -51,6 +81,33 @@ type content struct {
 	Sha  string `json:"sha"`
 }
 
+type contentCreateUpdate struct {
+	Branch    string    `json:"branch,omitempty"`
+	Message   string    `json:"message"`
+	Content   []byte    `json:"content,omitempty"`
+	Sha       string    `json:"sha,omitempty"`
+	Author    *identity `json:"author,omitempty"`
+	Committer *identity `json:"committer,omitempty"`
+}
+
+type identity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// convertIdentity returns the commit identity, or nil if the
+// signature is empty and the authenticated user is the
+// commit author.
+func convertIdentity(from scm.Signature) *identity {
+	if from.Name == "" || from.Email == "" {
+		return nil
+	}
+	return &identity{
+		Name:  from.Name,
+		Email: from.Email,
+	}
+}
+
 func convertContentInfoList(from []*content) []*scm.ContentInfo {
 	to := []*scm.ContentInfo{}
 	for _, v := range from {
@@ -44,26 +44,103 @@ func TestContentFind(t *testing.T) {
 }
 
 func TestContentCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		JSON(map[string]interface{}{
+			"branch":    "master",
+			"message":   "my commit message",
+			"content":   "SGVsbG8gV29ybGQK",
+			"author":    map[string]string{"name": "Jane Doe", "email": "jane@example.com"},
+			"committer": map[string]string{"name": "Jane Doe", "email": "jane@example.com"},
+		}).
+		Reply(201).
+		Type("application/json")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "my commit message",
+		Data:    []byte("Hello World\n"),
+		Signature: scm.Signature{
+			Name:  "Jane Doe",
+			Email: "jane@example.com",
+		},
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Create(context.Background(), "go-gitea/gitea", "README.md", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
 	}
 }
 
 func TestContentUpdate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Put("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		JSON(map[string]interface{}{
+			"branch":  "master",
+			"message": "my commit message",
+			"content": "SGVsbG8gV29ybGQK",
+			"sha":     "95d9a4ee0a3ad8da9e0c0d8f5dfb0f70bd7a04aa",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "my commit message",
+		Data:    []byte("Hello World\n"),
+		BlobID:  "95d9a4ee0a3ad8da9e0c0d8f5dfb0f70bd7a04aa",
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "README.md", nil)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Update(context.Background(), "go-gitea/gitea", "README.md", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 200 {
+		t.Errorf("Unexpected Results")
 	}
 }
 
 func TestContentDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/contents/README.md").
+		JSON(map[string]interface{}{
+			"branch":  "master",
+			"message": "my commit message",
+			"sha":     "95d9a4ee0a3ad8da9e0c0d8f5dfb0f70bd7a04aa",
+		}).
+		Reply(200).
+		Type("application/json")
+
+	params := &scm.ContentParams{
+		Branch:  "master",
+		Message: "my commit message",
+		BlobID:  "95d9a4ee0a3ad8da9e0c0d8f5dfb0f70bd7a04aa",
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Contents.Delete(context.Background(), "go-gitea/gitea", "README.md", &scm.ContentParams{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	res, err := client.Contents.Delete(context.Background(), "go-gitea/gitea", "README.md", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 200 {
+		t.Errorf("Unexpected Results")
 	}
 }
 
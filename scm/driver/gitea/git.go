@@ -18,7 +18,18 @@ type gitService struct {
 }
 
 func (s *gitService) CreateBranch(ctx context.Context, repo string, params *scm.ReferenceInput) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	// creating a branch from a commit sha was added in 1.21.
+	// Older versions create the branch from old_branch_name,
+	// which must be a branch.
+	if !s.client.VersionAtLeast("1.21") {
+		return nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/branches", repo)
+	in := &createBranch{
+		Name:       scm.TrimRef(params.Name),
+		OldRefName: params.Sha,
+	}
+	return s.client.do(ctx, "POST", path, in, nil)
 }
 
 func (s *gitService) FindBranch(ctx context.Context, repo, name string) (*scm.Reference, *scm.Response, error) {
@@ -80,11 +91,26 @@ func (s *gitService) ListTags(ctx context.Context, repo string, _ scm.ListOption
 }
 
 func (s *gitService) ListChanges(ctx context.Context, repo, ref string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// the files affected by the commit were added in 1.17.
+	if !s.client.VersionAtLeast("1.17") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	ref = scm.TrimRef(ref)
+	path := fmt.Sprintf("api/v1/repos/%s/git/commits/%s", repo, url.PathEscape(ref))
+	out := new(commitInfo)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCommitFileList(out.Files), res, err
 }
 
 func (s *gitService) CompareChanges(ctx context.Context, repo, source, target string, _ scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// the compare endpoint was added in 1.22.
+	if !s.client.VersionAtLeast("1.22") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/compare/%s...%s", repo, source, target)
+	out := new(compare)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertCompare(out), res, err
 }
 
 //
@@ -111,10 +137,29 @@ type (
 
 	// gitea commit info object.
 	commitInfo struct {
-		Sha       string `json:"sha"`
-		Commit    commit `json:"commit"`
-		Author    user   `json:"author"`
-		Committer user   `json:"committer"`
+		Sha       string        `json:"sha"`
+		Commit    commit        `json:"commit"`
+		Author    user          `json:"author"`
+		Committer user          `json:"committer"`
+		Files     []*commitFile `json:"files"`
+	}
+
+	// gitea commit affected file object.
+	commitFile struct {
+		Filename string `json:"filename"`
+		Status   string `json:"status"`
+	}
+
+	// gitea compare object.
+	compare struct {
+		TotalCommits int           `json:"total_commits"`
+		Commits      []*commitInfo `json:"commits"`
+	}
+
+	// gitea branch create object.
+	createBranch struct {
+		Name       string `json:"new_branch_name"`
+		OldRefName string `json:"old_ref_name,omitempty"`
 	}
 
 	// gitea signature object.
@@ -174,6 +219,54 @@ func convertCommitInfo(src *commitInfo) *scm.Commit {
 	}
 }
 
+func convertCommitFileList(src []*commitFile) []*scm.Change {
+	dst := []*scm.Change{}
+	for _, v := range src {
+		dst = append(dst, convertCommitFile(v))
+	}
+	return dst
+}
+
+func convertCommitFile(src *commitFile) *scm.Change {
+	return &scm.Change{
+		Path:    src.Filename,
+		Added:   src.Status == "added",
+		Deleted: src.Status == "removed" || src.Status == "deleted",
+	}
+}
+
+// convertCompare returns the files changed by the compared
+// commits. The compare response does not include the diff,
+// so the changes are merged from the files of each commit,
+// where the most recent commit determines the file status.
+// A file added and then deleted is not changed, and is
+// omitted. Renames are not detected, and are reported as a
+// deleted and an added file. The commits are listed newest
+// first.
+func convertCompare(src *compare) []*scm.Change {
+	changes := []*scm.Change{}
+	index := map[string]*scm.Change{}
+	for i := len(src.Commits) - 1; i >= 0; i-- {
+		for _, file := range src.Commits[i].Files {
+			change := convertCommitFile(file)
+			if prev, ok := index[change.Path]; ok {
+				prev.Deleted = change.Deleted
+				continue
+			}
+			index[change.Path] = change
+			changes = append(changes, change)
+		}
+	}
+	dst := []*scm.Change{}
+	for _, change := range changes {
+		if change.Added && change.Deleted {
+			continue
+		}
+		dst = append(dst, change)
+	}
+	return dst
+}
+
 func convertSignature(src signature) scm.Signature {
 	return scm.Signature{
 		Login: src.Username,
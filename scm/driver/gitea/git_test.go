@@ -70,7 +70,33 @@ func TestGitListCommits(t *testing.T) {
 }
 
 func TestGitListChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/git/commits/f05f642b892d59a0a9ef6a31f6c905a24b5db13a").
+		Reply(200).
+		Type("application/json").
+		File("testdata/commit_files.json")
+
 	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/commit_files.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitListChanges_NotSupported(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	client.Version = "1.16.0"
 	_, _, err := client.Git.ListChanges(context.Background(), "go-gitea/gitea", "f05f642b892d59a0a9ef6a31f6c905a24b5db13a", scm.ListOptions{})
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
@@ -78,7 +104,39 @@ func TestGitListChanges(t *testing.T) {
 }
 
 func TestGitCompareChanges(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/go-gitea/gitea/compare/d293a2b9d6722dffde7998c953c3087e47a38a83...f05f642b892d59a0a9ef6a31f6c905a24b5db13a").
+		Reply(200).
+		Type("application/json").
+		File("testdata/compare.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Git.CompareChanges(
+		context.Background(),
+		"go-gitea/gitea",
+		"d293a2b9d6722dffde7998c953c3087e47a38a83",
+		"f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+		scm.ListOptions{},
+	)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want := []*scm.Change{}
+	raw, _ := ioutil.ReadFile("testdata/compare.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestGitCompareChanges_NotSupported(t *testing.T) {
 	client, _ := New("https://try.gitea.io")
+	client.Version = "1.21.0"
 	_, _, err := client.Git.CompareChanges(
 		context.Background(),
 		"go-gitea/gitea",
@@ -95,6 +153,50 @@ func TestGitCompareChanges(t *testing.T) {
 // branch sub-tests
 //
 
+func TestGitCreateBranch(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/branches").
+		JSON(map[string]string{
+			"new_branch_name": "release/1.0",
+			"old_ref_name":    "f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+		}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/branch.json")
+
+	params := &scm.ReferenceInput{
+		Name: "release/1.0",
+		Sha:  "f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	res, err := client.Git.CreateBranch(context.Background(), "go-gitea/gitea", params)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if res.Status != 201 {
+		t.Errorf("Unexpected Results")
+	}
+}
+
+func TestGitCreateBranch_NotSupported(t *testing.T) {
+	params := &scm.ReferenceInput{
+		Name: "release/1.0",
+		Sha:  "f05f642b892d59a0a9ef6a31f6c905a24b5db13a",
+	}
+
+	client, _ := New("https://try.gitea.io")
+	client.Version = "1.20.0"
+	_, err := client.Git.CreateBranch(context.Background(), "go-gitea/gitea", params)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
 func TestGitFindBranch(t *testing.T) {
 	defer gock.Off()
 
@@ -43,9 +43,14 @@ func New(uri string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Capabilities = scm.CopyCapabilities(capabilities)
 	return client.Client, nil
 }
 
+// capabilities maps each capability supported by the
+// driver to the minimum Gitea version.
+var capabilities = map[scm.Capability]string{}
+
 // wraper wraps the Client to provide high level helper functions
 // for making http requests and unmarshaling the response.
 type wrapper struct {
@@ -171,10 +171,44 @@ func convertIssueComment(from *issueComment) *scm.Comment {
 	}
 }
 
-func (s *issueService) AddReaction(context.Context, string, int, int, *scm.ReactionInput) (*scm.Reaction, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// AddReaction adds a reaction to an issue or pull request
+// comment. Gitea does not assign the reaction an id.
+func (s *issueService) AddReaction(ctx context.Context, repo string, number, id int, input *scm.ReactionInput) (*scm.Reaction, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d/reactions", repo, id)
+	in := &reactionInput{
+		Content: input.Content,
+	}
+	out := new(reaction)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertReaction(out), res, err
 }
 
-func (s *issueService) DeleteReaction(context.Context, string, int, int, string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+// DeleteReaction removes the authenticated user's reaction
+// from an issue or pull request comment. Gitea identifies
+// the reaction by its content, so the reaction id is
+// expected to be the content (e.g. "+1").
+func (s *issueService) DeleteReaction(ctx context.Context, repo string, number, id int, reaction string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d/reactions", repo, id)
+	in := &reactionInput{
+		Content: reaction,
+	}
+	return s.client.do(ctx, "DELETE", path, in, nil)
+}
+
+type reaction struct {
+	User      user      `json:"user"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type reactionInput struct {
+	Content string `json:"content"`
+}
+
+func convertReaction(from *reaction) *scm.Reaction {
+	return &scm.Reaction{
+		User:    *convertUser(&from.User),
+		Content: from.Content,
+		Created: from.CreatedAt,
+	}
 }
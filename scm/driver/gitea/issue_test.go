@@ -203,3 +203,49 @@ func TestIssueCommentDelete(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+//
+// issue reaction sub-tests
+//
+
+func TestIssueAddReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/issues/comments/74/reactions").
+		JSON(map[string]string{"content": "+1"}).
+		Reply(201).
+		Type("application/json").
+		File("testdata/reaction.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Issues.AddReaction(context.Background(), "go-gitea/gitea", 1, 74, &scm.ReactionInput{Content: "+1"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Reaction)
+	raw, _ := ioutil.ReadFile("testdata/reaction.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestIssueDeleteReaction(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/go-gitea/gitea/issues/comments/74/reactions").
+		JSON(map[string]string{"content": "+1"}).
+		Reply(200)
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Issues.DeleteReaction(context.Background(), "go-gitea/gitea", 1, 74, "+1")
+	if err != nil {
+		t.Error(err)
+	}
+}
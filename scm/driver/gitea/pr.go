@@ -5,6 +5,7 @@
 package gitea
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -23,8 +24,11 @@ func (s *pullService) Find(ctx context.Context, repo string, index int) (*scm.Pu
 	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) FindComment(context.Context, string, int, int) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) FindComment(ctx context.Context, repo string, index, id int) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertIssueComment(out), res, err
 }
 
 func (s *pullService) List(ctx context.Context, repo string, opts scm.PullRequestListOptions) ([]*scm.PullRequest, *scm.Response, error) {
@@ -34,20 +38,65 @@ func (s *pullService) List(ctx context.Context, repo string, opts scm.PullReques
 	return convertPullRequests(out), res, err
 }
 
-func (s *pullService) ListComments(context.Context, string, int, scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListComments(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments?%s", repo, index, encodeListOptions(opts))
+	out := []*issueComment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertIssueCommentList(out), res, err
 }
 
-func (s *pullService) ListCommits(context.Context, string, int, scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListCommits(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Commit, *scm.Response, error) {
+	// the pull request commits endpoint was added in 1.16.
+	if !s.client.VersionAtLeast("1.16") {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/commits?%s", repo, index, encodeListOptions(opts))
+	out := []*commitInfo{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertCommitList(out), res, err
 }
 
-func (s *pullService) ListChanges(context.Context, string, int, scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) ListChanges(ctx context.Context, repo string, index int, opts scm.ListOptions) ([]*scm.Change, *scm.Response, error) {
+	// the pull request files endpoint was added in 1.17.
+	// older versions fallback to parsing the pull request
+	// diff, which is not paginated.
+	if !s.client.VersionAtLeast("1.17") {
+		if opts.Page > 1 {
+			return []*scm.Change{}, nil, nil
+		}
+		return s.listDiffChanges(ctx, repo, index)
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/files?%s", repo, index, encodeListOptions(opts))
+	out := []*changedFile{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertChangedFileList(out), res, err
 }
 
-func (s *pullService) GetPRFileDiff(context.Context, string, int, string) (*scm.Change, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) GetPRFileDiff(ctx context.Context, repo string, index int, path string) (*scm.Change, *scm.Response, error) {
+	// the changed files do not include the patch, so the
+	// file is found in the pull request diff.
+	changes, res, err := s.listDiffChanges(ctx, repo, index)
+	if err != nil {
+		return nil, res, err
+	}
+	for _, change := range changes {
+		if change.Path == path || change.PrevFilePath == path {
+			return change, res, nil
+		}
+	}
+	return nil, res, nil
+}
+
+// listDiffChanges returns the changes parsed from the pull
+// request diff.
+func (s *pullService) listDiffChanges(ctx context.Context, repo string, index int) ([]*scm.Change, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d.diff", repo, index)
+	buf := new(bytes.Buffer)
+	res, err := s.client.do(ctx, "GET", path, nil, buf)
+	if err != nil {
+		return nil, res, err
+	}
+	return parseDiff(buf.String()), res, nil
 }
 
 func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRequestInput) (*scm.PullRequest, *scm.Response, error) {
@@ -63,16 +112,29 @@ func (s *pullService) Create(ctx context.Context, repo string, input *scm.PullRe
 	return convertPullRequest(out), res, err
 }
 
-func (s *pullService) CreateComment(context.Context, string, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) CreateComment(ctx context.Context, repo string, index int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d/comments", repo, index)
+	in := &issueCommentInput{
+		Body: input.Body,
+	}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	return convertIssueComment(out), res, err
 }
 
-func (s *pullService) EditComment(context.Context, string, int, int, *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+func (s *pullService) EditComment(ctx context.Context, repo string, index, id int, input *scm.CommentInput) (*scm.Comment, *scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	in := &issueCommentInput{
+		Body: input.Body,
+	}
+	out := new(issueComment)
+	res, err := s.client.do(ctx, "PATCH", path, in, out)
+	return convertIssueComment(out), res, err
 }
 
-func (s *pullService) DeleteComment(context.Context, string, int, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) DeleteComment(ctx context.Context, repo string, index, id int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/issues/comments/%d", repo, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
 func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.Response, error) {
@@ -81,8 +143,13 @@ func (s *pullService) Merge(ctx context.Context, repo string, index int) (*scm.R
 	return res, err
 }
 
-func (s *pullService) Close(context.Context, string, int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+func (s *pullService) Close(ctx context.Context, repo string, index int) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, index)
+	in := &prStateInput{
+		State: "closed",
+	}
+	res, err := s.client.do(ctx, "PATCH", path, in, nil)
+	return res, err
 }
 
 //
@@ -127,6 +194,22 @@ type prInput struct {
 	Base  string `json:"base"`
 }
 
+type prStateInput struct {
+	State string `json:"state"`
+}
+
+type changedFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Changes          int    `json:"changes"`
+	HTMLURL          string `json:"html_url"`
+	ContentsURL      string `json:"contents_url"`
+	RawURL           string `json:"raw_url"`
+}
+
 //
 // native data structure conversion
 //
@@ -167,6 +250,24 @@ func convertPullRequest(src *pr) *scm.PullRequest {
 	}
 }
 
+func convertChangedFileList(src []*changedFile) []*scm.Change {
+	dst := []*scm.Change{}
+	for _, v := range src {
+		dst = append(dst, convertChangedFile(v))
+	}
+	return dst
+}
+
+func convertChangedFile(src *changedFile) *scm.Change {
+	return &scm.Change{
+		Path:         src.Filename,
+		PrevFilePath: src.PreviousFilename,
+		Added:        src.Status == "added",
+		Deleted:      src.Status == "deleted",
+		Renamed:      src.Status == "renamed",
+	}
+}
+
 func convertPullRequestFromIssue(src *issue) *scm.PullRequest {
 	return &scm.PullRequest{
 		Number:  src.Number,
@@ -180,10 +281,16 @@ func convertPullRequestFromIssue(src *issue) *scm.PullRequest {
 	}
 }
 
-func (s *pullService) AddReaction(context.Context, string, int, int, *scm.ReactionInput) (*scm.Reaction, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+// AddReaction adds a reaction to a pull request comment.
+// Pull request comments are issue comments in gitea.
+func (s *pullService) AddReaction(ctx context.Context, repo string, number, id int, input *scm.ReactionInput) (*scm.Reaction, *scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.AddReaction(ctx, repo, number, id, input)
 }
 
-func (s *pullService) DeleteReaction(context.Context, string, int, int, string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+// DeleteReaction removes a reaction from a pull request
+// comment. See issueService.DeleteReaction.
+func (s *pullService) DeleteReaction(ctx context.Context, repo string, number, id int, reaction string) (*scm.Response, error) {
+	issues := &issueService{s.client}
+	return issues.DeleteReaction(ctx, repo, number, id, reaction)
 }
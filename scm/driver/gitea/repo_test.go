@@ -230,6 +230,21 @@ func TestHookDelete(t *testing.T) {
 	}
 }
 
+func TestHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/go-gitea/gitea/hooks/20/tests").
+		Reply(204).
+		Type("application/json")
+
+	client, _ := New("https://try.gitea.io")
+	_, err := client.Repositories.PingHook(context.Background(), "go-gitea/gitea", "20")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestHookEvents(t *testing.T) {
 	tests := []struct {
 		in  scm.HookEvents
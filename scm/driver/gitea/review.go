@@ -6,26 +6,194 @@ package gitea
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// reviewService implements the review service using pull
+// request reviews. The review identifier is the gitea review
+// id, and the path and line are those of the first comment
+// in the review.
 type reviewService struct {
 	client *wrapper
 }
 
 func (s *reviewService) Find(ctx context.Context, repo string, number, id int) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d", repo, number, id)
+	out := new(review)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	comments, _, err := s.listComments(ctx, repo, number, out)
+	if err != nil {
+		return nil, res, err
+	}
+	return convertReview(out, comments), res, nil
 }
 
 func (s *reviewService) List(ctx context.Context, repo string, number int, opts scm.ListOptions) ([]*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews?%s", repo, number, encodeListOptions(opts))
+	out := []*review{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	// the review list does not include the review comments,
+	// so the comments are requested for each review.
+	reviews := []*scm.Review{}
+	for _, v := range out {
+		comments, _, err := s.listComments(ctx, repo, number, v)
+		if err != nil {
+			return nil, res, err
+		}
+		reviews = append(reviews, convertReview(v, comments))
+	}
+	return reviews, res, nil
 }
 
 func (s *reviewService) Create(ctx context.Context, repo string, number int, input *scm.ReviewInput) (*scm.Review, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	// gitea does not support replies to review comments.
+	if input.InReplyTo != 0 {
+		return nil, nil, scm.ErrNotSupported
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews", repo, number)
+	in := &reviewInput{
+		CommitID: input.Sha,
+		Event:    "COMMENT",
+	}
+	comment := convertReviewCommentInput(input)
+	if comment != nil {
+		in.Comments = []*reviewCommentInput{comment}
+	} else {
+		in.Body = input.Body
+	}
+	out := new(review)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := convertReview(out, nil)
+	if comment != nil {
+		to.Body = input.Body
+		to.Path = input.Path
+		to.Line = input.Line
+	}
+	return to, res, nil
 }
 
 func (s *reviewService) Delete(ctx context.Context, repo string, number, id int) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d", repo, number, id)
+	return s.client.do(ctx, "DELETE", path, nil, nil)
+}
+
+// listComments returns the review comments, or nil if the
+// review has no comments.
+func (s *reviewService) listComments(ctx context.Context, repo string, number int, from *review) ([]*reviewComment, *scm.Response, error) {
+	if from.CommentsCount == 0 {
+		return nil, nil, nil
+	}
+	path := fmt.Sprintf("api/v1/repos/%s/pulls/%d/reviews/%d/comments", repo, number, from.ID)
+	out := []*reviewComment{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return out, res, err
+}
+
+//
+// native data structures
+//
+
+type (
+	// gitea pull request review object.
+	review struct {
+		ID            int       `json:"id"`
+		User          user      `json:"user"`
+		Body          string    `json:"body"`
+		CommitID      string    `json:"commit_id"`
+		State         string    `json:"state"`
+		HTMLURL       string    `json:"html_url"`
+		CommentsCount int       `json:"comments_count"`
+		Submitted     time.Time `json:"submitted_at"`
+		Updated       time.Time `json:"updated_at"`
+	}
+
+	// gitea pull request review comment object.
+	reviewComment struct {
+		ID               int       `json:"id"`
+		User             user      `json:"user"`
+		Body             string    `json:"body"`
+		Path             string    `json:"path"`
+		CommitID         string    `json:"commit_id"`
+		Position         int       `json:"position"`
+		OriginalPosition int       `json:"original_position"`
+		HTMLURL          string    `json:"html_url"`
+		Created          time.Time `json:"created_at"`
+		Updated          time.Time `json:"updated_at"`
+	}
+
+	// gitea pull request review create object.
+	reviewInput struct {
+		Body     string                `json:"body,omitempty"`
+		CommitID string                `json:"commit_id,omitempty"`
+		Event    string                `json:"event"`
+		Comments []*reviewCommentInput `json:"comments,omitempty"`
+	}
+
+	// gitea pull request review comment create object.
+	reviewCommentInput struct {
+		Path        string `json:"path"`
+		Body        string `json:"body"`
+		OldPosition int    `json:"old_position"`
+		NewPosition int    `json:"new_position"`
+	}
+)
+
+//
+// native data structure conversion
+//
+
+// convertReviewCommentInput returns the review comment, or nil
+// if the review is not anchored to a line. Gitea comments are
+// anchored to a single line, so the end line of a multi-line
+// comment is used.
+func convertReviewCommentInput(from *scm.ReviewInput) *reviewCommentInput {
+	if from.SubjectType == scm.SubjectTypeFile || from.Path == "" || from.Line == 0 {
+		return nil
+	}
+	to := &reviewCommentInput{
+		Path: from.Path,
+		Body: from.Body,
+	}
+	if from.Side == scm.SideLeft {
+		to.OldPosition = from.Line
+	} else {
+		to.NewPosition = from.Line
+	}
+	return to
+}
+
+func convertReview(from *review, comments []*reviewComment) *scm.Review {
+	to := &scm.Review{
+		ID:      from.ID,
+		Body:    from.Body,
+		Sha:     from.CommitID,
+		Link:    from.HTMLURL,
+		Author:  *convertUser(&from.User),
+		Created: from.Submitted,
+		Updated: from.Updated,
+	}
+	if len(comments) != 0 {
+		first := comments[0]
+		to.Path = first.Path
+		to.Line = first.Position
+		if to.Line == 0 {
+			to.Line = first.OriginalPosition
+		}
+		if to.Body == "" {
+			to.Body = first.Body
+		}
+	}
+	return to
 }
@@ -6,39 +6,200 @@ package gitea
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 )
 
 func TestReviewFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/12").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/12/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comments.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Reviews.Find(context.Background(), "go-gitea/gitea", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Reviews.Find(context.Background(), "jcitizen/my-repo", 1, 12)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewFind_NoComments(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/12").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_body.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, res, err := client.Reviews.Find(context.Background(), "jcitizen/my-repo", 1, 12)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review_body.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if res == nil {
+		t.Errorf("Expect the review response returned")
 	}
 }
 
 func TestReviewList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		MatchParam("page", "1").
+		MatchParam("limit", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/reviews.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/12/comments").
+		Reply(200).
+		Type("application/json").
+		File("testdata/review_comments.json")
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Reviews.List(context.Background(), "go-gitea/gitea", 1, scm.ListOptions{})
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	got, _, err := client.Reviews.List(context.Background(), "jcitizen/my-repo", 1, scm.ListOptions{Page: 1, Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Review{}
+	raw, _ := ioutil.ReadFile("testdata/reviews.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
 	}
 }
 
 func TestReviewCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		JSON(map[string]interface{}{
+			"commit_id": "2eba238e33607c1fa49253182e9fff42baafa1eb",
+			"event":     "COMMENT",
+			"comments": []map[string]interface{}{
+				{
+					"path":         "main.go",
+					"body":         "unused variable",
+					"old_position": 0,
+					"new_position": 3,
+				},
+			},
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	input := &scm.ReviewInput{
+		Body:      "unused variable",
+		Sha:       "2eba238e33607c1fa49253182e9fff42baafa1eb",
+		Path:      "main.go",
+		Line:      3,
+		StartLine: 1,
+		Side:      scm.SideRight,
+	}
+
 	client, _ := New("https://try.gitea.io")
-	_, _, err := client.Reviews.Create(context.Background(), "go-gitea/gitea", 1, nil)
+	got, _, err := client.Reviews.Create(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Review)
+	raw, _ := ioutil.ReadFile("testdata/review.json.golden")
+	json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReviewCreate_Left(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Post("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews").
+		BodyString(`"old_position":3,"new_position":0`).
+		Reply(200).
+		Type("application/json").
+		File("testdata/review.json")
+
+	input := &scm.ReviewInput{
+		Body: "unused variable",
+		Path: "main.go",
+		Line: 3,
+		Side: scm.SideLeft,
+	}
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Reviews.Create(context.Background(), "jcitizen/my-repo", 1, input)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReviewCreate_Reply(t *testing.T) {
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Reviews.Create(context.Background(), "jcitizen/my-repo", 1, &scm.ReviewInput{InReplyTo: 31})
 	if err != scm.ErrNotSupported {
 		t.Errorf("Expect Not Supported error")
 	}
 }
 
 func TestReviewDelete(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Delete("/api/v1/repos/jcitizen/my-repo/pulls/1/reviews/12").
+		Reply(204)
+
 	client, _ := New("https://try.gitea.io")
-	_, err := client.Reviews.Delete(context.Background(), "go-gitea/gitea", 1, 1)
-	if err != scm.ErrNotSupported {
-		t.Errorf("Expect Not Supported error")
+	_, err := client.Reviews.Delete(context.Background(), "jcitizen/my-repo", 1, 12)
+	if err != nil {
+		t.Error(err)
 	}
 }
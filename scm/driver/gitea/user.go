@@ -7,6 +7,7 @@ package gitea
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -21,6 +22,46 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	info := &scm.TokenInfo{User: *user}
+
+	// gitea does not provide an endpoint to inspect the
+	// current token. Instead we list the user tokens and
+	// match the current token by its last eight characters.
+	// The scopes are unknown, and left nil, if the token is
+	// not in the request context. Gitea only lists the user
+	// tokens with basic auth, so the error is returned when
+	// the request is authenticated with the token.
+	token, ok := ctx.Value(scm.TokenKey{}).(*scm.Token)
+	if !ok || token == nil || len(token.Token) < 8 {
+		return info, res, nil
+	}
+	path := fmt.Sprintf("api/v1/users/%s/tokens", user.Login)
+	out := []*accessToken{}
+	res, err = s.client.do(ctx, "GET", path, nil, &out)
+	if err != nil {
+		return nil, res, err
+	}
+	suffix := token.Token[len(token.Token)-8:]
+	for _, v := range out {
+		if v.LastEight == suffix {
+			info.ID = strconv.Itoa(v.ID)
+			info.Name = v.Name
+			info.Type = "personal"
+			info.Scopes = v.Scopes
+			if info.Scopes == nil {
+				info.Scopes = []string{}
+			}
+			break
+		}
+	}
+	return info, res, nil
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("api/v1/users/%s", login)
 	out := new(user)
@@ -50,6 +91,13 @@ type user struct {
 	Avatar   string `json:"avatar_url"`
 }
 
+type accessToken struct {
+	ID        int      `json:"id"`
+	Name      string   `json:"name"`
+	LastEight string   `json:"token_last_eight"`
+	Scopes    []string `json:"scopes"`
+}
+
 //
 // native data structure conversion
 //
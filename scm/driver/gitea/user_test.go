@@ -41,6 +41,91 @@ func TestUserFind(t *testing.T) {
 	}
 }
 
+func TestUserFindTokenInfo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/users/jcitizen/tokens").
+		Reply(200).
+		Type("application/json").
+		BodyString(`[{"id":1,"name":"laptop","sha1":"","token_last_eight":"aaaaaaaa","scopes":["all"]},{"id":2,"name":"drone","sha1":"","token_last_eight":"9f3c2a1b","scopes":["read:repository","write:issue"]}]`)
+
+	ctx := scm.WithContext(context.Background(), &scm.Token{Token: "0d5f1c5b8a7e64c2e1f7a3d29f3c2a1b"})
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.FindTokenInfo(ctx)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.ID, "2"; got != want {
+		t.Errorf("Want token id %q, got %q", want, got)
+	}
+	if got, want := got.Name, "drone"; got != want {
+		t.Errorf("Want token name %q, got %q", want, got)
+	}
+	if diff := cmp.Diff(got.Scopes, []string{"read:repository", "write:issue"}); diff != "" {
+		t.Errorf("Unexpected Scopes")
+		t.Log(diff)
+	}
+	if got, want := got.User.Login, "jcitizen"; got != want {
+		t.Errorf("Want token user %q, got %q", want, got)
+	}
+}
+
+func TestUserFindTokenInfo_Unknown(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	client, _ := New("https://try.gitea.io")
+	got, _, err := client.Users.FindTokenInfo(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.Scopes != nil {
+		t.Errorf("Expect unknown scopes, got %v", got.Scopes)
+	}
+	if got, want := got.User.Login, "jcitizen"; got != want {
+		t.Errorf("Want token user %q, got %q", want, got)
+	}
+}
+
+func TestUserFindTokenInfo_BasicAuthRequired(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/user").
+		Reply(200).
+		Type("application/json").
+		File("testdata/user.json")
+
+	gock.New("https://try.gitea.io").
+		Get("/api/v1/users/jcitizen/tokens").
+		Reply(401).
+		Type("application/json").
+		BodyString(`{"message":"auth required"}`)
+
+	ctx := scm.WithContext(context.Background(), &scm.Token{Token: "0d5f1c5b8a7e64c2e1f7a3d29f3c2a1b"})
+
+	client, _ := New("https://try.gitea.io")
+	_, _, err := client.Users.FindTokenInfo(ctx)
+	if err == nil {
+		t.Errorf("Expect error listing tokens without basic auth")
+	}
+}
+
 func TestUserLoginFind(t *testing.T) {
 	defer gock.Off()
 
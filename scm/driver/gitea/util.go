@@ -100,4 +100,73 @@ func encodeReleaseListOptions(o ListOptions) string {
 	query.Add("page", fmt.Sprintf("%d", o.Page))
 	query.Add("limit", fmt.Sprintf("%d", o.PageSize))
 	return query.Encode()
-}
\ No newline at end of file
+}
+
+// parseDiff returns the changes in the unified git diff,
+// including the patch of each file. The path is read from
+// the rename and file header lines, falling back to the
+// diff header for changes without file headers, such as
+// binary files and mode changes.
+func parseDiff(diff string) []*scm.Change {
+	changes := []*scm.Change{}
+	var change *scm.Change
+	var patch []string
+	flush := func() {
+		if change != nil {
+			change.Patch = strings.Join(patch, "\n")
+			changes = append(changes, change)
+		}
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			flush()
+			change, patch = new(scm.Change), nil
+			change.Path = parseDiffHeader(line)
+		case change == nil:
+			// ignore any preamble before the first file.
+		case patch != nil || strings.HasPrefix(line, "@@"):
+			patch = append(patch, line)
+		case strings.HasPrefix(line, "new file mode"):
+			change.Added = true
+		case strings.HasPrefix(line, "deleted file mode"):
+			change.Deleted = true
+		case strings.HasPrefix(line, "rename from "):
+			change.Renamed = true
+			change.PrevFilePath = strings.TrimPrefix(line, "rename from ")
+		case strings.HasPrefix(line, "rename to "):
+			change.Path = strings.TrimPrefix(line, "rename to ")
+		case strings.HasPrefix(line, "--- a/") && change.Deleted:
+			change.Path = parseFileHeader(line, "--- a/")
+		case strings.HasPrefix(line, "+++ b/"):
+			change.Path = parseFileHeader(line, "+++ b/")
+		}
+	}
+	flush()
+	return changes
+}
+
+// parseFileHeader returns the path from the --- or +++ file
+// header line. Git terminates the path with a tab if the
+// path contains a space.
+func parseFileHeader(line, prefix string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(line, prefix), "\t")
+}
+
+// parseDiffHeader returns the path from the diff --git
+// header line. The a/ and b/ paths are the same unless the
+// file is renamed, in which case the path is read from the
+// rename lines, so the path is the second half of the line.
+func parseDiffHeader(line string) string {
+	paths := strings.TrimPrefix(line, "diff --git ")
+	if n := len(paths); n%2 == 1 {
+		a, b := paths[:n/2], paths[n/2+1:]
+		if strings.HasPrefix(a, "a/") && strings.HasPrefix(b, "b/") && a[2:] == b[2:] {
+			return b[2:]
+		}
+	}
+	if i := strings.LastIndex(paths, " b/"); i != -1 {
+		return paths[i+3:]
+	}
+	return ""
+}
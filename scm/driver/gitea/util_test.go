@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func Test_encodeListOptions(t *testing.T) {
@@ -79,3 +81,32 @@ func Test_encodePullRequestListOptions_Closed(t *testing.T) {
 		t.Errorf("Want encoded pr list options %q, got %q", want, got)
 	}
 }
+
+func Test_parseDiff(t *testing.T) {
+	diff := "diff --git a/a b/c b/a b/c\n" +
+		"deleted file mode 100644\n" +
+		"index 3b18e51..0000000\n" +
+		"--- a/a b/c\t\n" +
+		"+++ /dev/null\n" +
+		"@@ -1 +0,0 @@\n" +
+		"-hello world\n" +
+		"diff --git a/logo.png b/logo.png\n" +
+		"new file mode 100644\n" +
+		"index 0000000..8a1218a\n" +
+		"Binary files /dev/null and b/logo.png differ\n" +
+		"diff --git a/old.txt b/new.txt\n" +
+		"similarity index 100%\n" +
+		"rename from old.txt\n" +
+		"rename to new.txt\n"
+
+	want := []*scm.Change{
+		{Path: "a b/c", Deleted: true, Patch: "@@ -1 +0,0 @@\n-hello world"},
+		{Path: "logo.png", Added: true},
+		{Path: "new.txt", PrevFilePath: "old.txt", Renamed: true},
+	}
+	got := parseDiff(diff)
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
@@ -43,7 +43,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	case "pull_request":
 		hook, err = s.parsePullRequestHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		err = scm.ErrUnknownEvent
+	}
+	if err == scm.ErrUnknownEvent && scm.RawHooksEnabled(req.Context()) {
+		hook, err = s.parseRawHook(req.Header.Get("X-Gitea-Event"), data)
 	}
 	if err != nil {
 		return nil, err
@@ -133,6 +136,12 @@ func (s *webhookService) parsePullRequestHook(data []byte) (scm.Webhook, error)
 	return convertPullRequestHook(dst), err
 }
 
+func (s *webhookService) parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	dst := new(rawHook)
+	err := json.Unmarshal(data, dst)
+	return convertRawHook(event, dst, data), err
+}
+
 //
 // native data structures
 //
@@ -169,6 +178,14 @@ type (
 		Sender     user         `json:"sender"`
 	}
 
+	// generic payload used to extract the repository and
+	// sender from events without a dedicated hook type.
+	rawHook struct {
+		Action     string      `json:"action"`
+		Repository *repository `json:"repository"`
+		Sender     *user       `json:"sender"`
+	}
+
 	// gitea pull request webhook payload
 	pullRequestHook struct {
 		Action      string     `json:"action"`
@@ -315,6 +332,21 @@ func convertPullRequestCommentHook(dst *issueHook) *scm.PullRequestCommentHook {
 	}
 }
 
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event:  event,
+		Action: src.Action,
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertRepository(src.Repository)
+	}
+	if src.Sender != nil {
+		dst.Sender = *convertUser(src.Sender)
+	}
+	return dst
+}
+
 func convertIssueHook(dst *issueHook) *scm.IssueHook {
 	return &scm.IssueHook{
 		Action: convertAction(dst.Action),
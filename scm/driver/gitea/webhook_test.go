@@ -231,3 +231,35 @@ func TestWebhook_MissingSignature(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "71295b197fa25f4356d2fb9965df3f2379d903d7", nil
 }
+
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/?secret=71295b197fa25f4356d2fb9965df3f2379d903d7", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitea-Event", "repository")
+	r.Header.Set("X-Gitea-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	s := new(webhookService)
+	o, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "repository"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Namespace+"/"+hook.Repo.Name, "gogits/hello-world"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "unknwon"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw payload preserved")
+	}
+}
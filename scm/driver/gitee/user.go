@@ -30,6 +30,10 @@ func (s *userService) FindEmail(ctx context.Context) (string, *scm.Response, err
 	return user.Email, res, err
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("users/%s", login)
 	out := new(user)
@@ -43,7 +43,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	case "Tag Push Hook":
 		hook, err = s.parseTagPushHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		err = scm.ErrUnknownEvent
+	}
+	if err == scm.ErrUnknownEvent && scm.RawHooksEnabled(req.Context()) {
+		hook, err = s.parseRawHook(req.Header.Get("X-Gitee-Event"), data)
 	}
 	if err != nil {
 		return nil, err
@@ -101,6 +104,12 @@ func (s *webhookService) parseNoteHook(data []byte) (scm.Webhook, error) {
 	return convertNoteHook(dst), err
 }
 
+func (s *webhookService) parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	dst := new(rawHook)
+	err := json.Unmarshal(data, dst)
+	return convertRawHook(event, dst, data), err
+}
+
 // validateSignature
 // see https://gitee.com/help/articles/4290#article-header3
 func validateSignature(signature, key, timestamp string) bool {
@@ -186,6 +195,13 @@ type (
 		Sender       user           `json:"sender"`
 		Enterprise   enterprise     `json:"enterprise"`
 	}
+	// generic payload used to extract the repository and
+	// sender from events without a dedicated hook type.
+	rawHook struct {
+		Action     string          `json:"action"`
+		Repository *hookRepository `json:"repository"`
+		Sender     *user           `json:"sender"`
+	}
 	issueHook struct {
 		Action     string         `json:"action"`
 		HookName   string         `json:"hook_name"`
@@ -360,6 +376,21 @@ func convertPullRequestHook(src *mergeRequestHook) *scm.PullRequestHook {
 	return dst
 }
 
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event:  event,
+		Action: src.Action,
+		Data:   data,
+	}
+	if src.Repository != nil {
+		dst.Repo = *convertHookRepository(src.Repository)
+	}
+	if src.Sender != nil {
+		dst.Sender = *convertUser(src.Sender)
+	}
+	return dst
+}
+
 func convertIssueHook(src *issueHook) *scm.IssueHook {
 	dst := &scm.IssueHook{
 		Repo:   *convertHookRepository(&src.Repository),
@@ -263,3 +263,44 @@ func TestWebhookValid(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "bBg5lrt03VixkX85CNqYIcecC0SIGASE", nil
 }
+
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/issue_hook_open.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitee-Event", "Wiki Hook")
+
+	s := new(webhookService)
+	if _, err := s.Parse(r, secretFunc); err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+
+	r, _ = http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitee-Event", "Wiki Hook")
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "Wiki Hook"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Action, "open"; got != want {
+		t.Errorf("Want action %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "drone-yml-test"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "kit101"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw payload preserved")
+	}
+}
@@ -46,6 +46,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Capabilities = scm.CopyCapabilities(capabilities)
 	return client.Client, nil
 }
 
@@ -56,6 +57,16 @@ func NewDefault() *scm.Client {
 	return client
 }
 
+// capabilities maps each capability supported by the
+// driver to the minimum GitHub Enterprise Server version.
+var capabilities = map[scm.Capability]string{
+	scm.CapabilityDraftPullRequests:       "2.17",
+	scm.CapabilityMergeQueue:              "3.12",
+	scm.CapabilityMultiLineReviewComments: "2.22",
+	scm.CapabilityHookDeliveries:          "3.2",
+	scm.CapabilityTokenInfo:               "",
+}
+
 // wraper wraps the Client to provide high level helper functions
 // for making http requests and unmarshaling the response.
 type wrapper struct {
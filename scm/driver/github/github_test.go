@@ -52,6 +52,17 @@ func TestClient_Default(t *testing.T) {
 	}
 }
 
+func TestClient_Capabilities(t *testing.T) {
+	a, b := NewDefault(), NewDefault()
+	delete(a.Capabilities, scm.CapabilityMergeQueue)
+	if a.Supports(scm.CapabilityMergeQueue) {
+		t.Errorf("Expect capability removed from the client")
+	}
+	if !b.Supports(scm.CapabilityMergeQueue) {
+		t.Errorf("Expect capability not removed from other clients")
+	}
+}
+
 func TestClient_Error(t *testing.T) {
 	_, err := New("http://a b.com/")
 	if err == nil {
@@ -6,8 +6,10 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -57,6 +59,26 @@ type hook struct {
 	} `json:"config"`
 }
 
+type hookDelivery struct {
+	ID          int       `json:"id"`
+	GUID        string    `json:"guid"`
+	DeliveredAt time.Time `json:"delivered_at"`
+	Redelivery  bool      `json:"redelivery"`
+	Duration    float64   `json:"duration"`
+	Status      string    `json:"status"`
+	StatusCode  int       `json:"status_code"`
+	Event       string    `json:"event"`
+	Action      string    `json:"action"`
+	Request     struct {
+		Headers map[string]string `json:"headers"`
+		Payload json.RawMessage   `json:"payload"`
+	} `json:"request"`
+	Response struct {
+		Headers map[string]string `json:"headers"`
+		Payload string            `json:"payload"`
+	} `json:"response"`
+}
+
 type repositoryList struct {
 	TotalCount   int           `json:"total_count"`
 	Repositories []*repository `json:"repositories"`
@@ -240,6 +262,43 @@ func (s *RepositoryService) DeleteHook(ctx context.Context, repo, id string) (*s
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// FindHookDelivery returns a repository webhook delivery.
+func (s *RepositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s", repo, id, delivery)
+	out := new(hookDelivery)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHookDelivery(out), res, err
+}
+
+// ListHookDeliveries returns a list of repository webhook deliveries.
+// The deliveries are paged with a cursor, so the next page is
+// returned in the response Page.NextURL, and is requested by
+// setting the ListOptions URL.
+func (s *RepositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries?%s", repo, id, encodeHookDeliveryListOptions(opts))
+	if opts.URL != "" {
+		path = opts.URL
+	}
+	out := []*hookDelivery{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	if res != nil {
+		res.Page.NextURL = nextCursorURL(res.Header.Get("Link"))
+	}
+	return convertHookDeliveryList(out), res, err
+}
+
+// RedeliverHook redelivers a repository webhook delivery.
+func (s *RepositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/deliveries/%s/attempts", repo, id, delivery)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+// PingHook sends a ping event to a repository webhook.
+func (s *RepositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("repos/%s/hooks/%s/pings", repo, id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
 // helper function to convert from the github repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -297,6 +356,51 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertHookDeliveryList(from []*hookDelivery) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookDelivery(v))
+	}
+	return to
+}
+
+func convertHookDelivery(from *hookDelivery) *scm.HookDelivery {
+	to := &scm.HookDelivery{
+		ID:         strconv.Itoa(from.ID),
+		GUID:       from.GUID,
+		Event:      from.Event,
+		Action:     from.Action,
+		Success:    from.StatusCode >= 200 && from.StatusCode < 300,
+		Redelivery: from.Redelivery,
+		StatusCode: from.StatusCode,
+		Status:     from.Status,
+		Duration:   time.Duration(from.Duration * float64(time.Second)),
+		Created:    from.DeliveredAt,
+		Request: scm.HookMessage{
+			Header: convertHookHeaders(from.Request.Headers),
+		},
+		Response: scm.HookMessage{
+			Header: convertHookHeaders(from.Response.Headers),
+			Body:   from.Response.Payload,
+		},
+	}
+	if len(from.Request.Payload) != 0 && string(from.Request.Payload) != "null" {
+		to.Request.Body = string(from.Request.Payload)
+	}
+	return to
+}
+
+func convertHookHeaders(from map[string]string) http.Header {
+	if len(from) == 0 {
+		return nil
+	}
+	to := http.Header{}
+	for k, v := range from {
+		to.Set(k, v)
+	}
+	return to
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push {
@@ -479,6 +479,160 @@ func TestRepositoryHookDelete(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestRepositoryHookDeliveryFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries/12345678").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_delivery.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.FindHookDelivery(context.Background(), "octocat/hello-world", "1", "12345678")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.HookDelivery)
+	raw, _ := ioutil.ReadFile("testdata/hook_delivery.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/hook_deliveries.json")
+
+	client := NewDefault()
+	got, res, err := client.Repositories.ListHookDeliveries(context.Background(), "octocat/hello-world", "1", scm.ListOptions{Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/hook_deliveries.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookDeliveryList_Cursor(t *testing.T) {
+	defer gock.Off()
+
+	next := "https://api.github.com/repos/octocat/hello-world/hooks/1/deliveries?cursor=v1_12077215967&per_page=30"
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		SetHeader("Link", "<"+next+">; rel=\"next\"").
+		File("testdata/hook_deliveries.json")
+
+	gock.New("https://api.github.com").
+		Get("/repos/octocat/hello-world/hooks/1/deliveries").
+		MatchParam("cursor", "v1_12077215967").
+		MatchParam("per_page", "30").
+		Reply(200).
+		Type("application/json").
+		File("testdata/hook_deliveries.json")
+
+	client := NewDefault()
+	_, res, err := client.Repositories.ListHookDeliveries(context.Background(), "octocat/hello-world", "1", scm.ListOptions{Size: 30})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := res.Page.NextURL, next; got != want {
+		t.Errorf("Want next url %q, got %q", want, got)
+	}
+
+	_, res, err = client.Repositories.ListHookDeliveries(context.Background(), "octocat/hello-world", "1", scm.ListOptions{URL: res.Page.NextURL})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got := res.Page.NextURL; got != "" {
+		t.Errorf("Want no next url, got %q", got)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestRepositoryHookRedeliver(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks/1/deliveries/12345678/attempts").
+		Reply(202).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.RedeliverHook(context.Background(), "octocat/hello-world", "1", "12345678")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 202; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestRepositoryHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Post("/repos/octocat/hello-world/hooks/1/pings").
+		Reply(204).
+		Type("application/json").
+		SetHeaders(mockHeaders)
+
+	client := NewDefault()
+	res, err := client.Repositories.PingHook(context.Background(), "octocat/hello-world", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if got, want := res.Status, 204; got != want {
+		t.Errorf("Want response status %d, got %d", want, got)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
 func TestConvertState(t *testing.T) {
 	tests := []struct {
 		src string
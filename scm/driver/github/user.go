@@ -7,6 +7,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -23,6 +24,29 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	out := new(user)
+	res, err := s.client.do(ctx, "GET", "user", nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	info := &scm.TokenInfo{
+		User: *convertUser(out),
+	}
+	if token, ok := ctx.Value(scm.TokenKey{}).(*scm.Token); ok && token != nil {
+		info.Type = convertTokenType(token.Token)
+	}
+	// classic tokens report the granted scopes in the response
+	// headers. Fine-grained tokens and app tokens do not.
+	if values, ok := res.Header["X-Oauth-Scopes"]; ok {
+		info.Scopes = splitScopes(values)
+	}
+	if v := res.Header.Get("GitHub-Authentication-Token-Expiration"); v != "" {
+		info.Expires = parseTokenExpiration(v)
+	}
+	return info, res, nil
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("users/%s", login)
 	out := new(user)
@@ -69,6 +93,53 @@ func convertUser(from *user) *scm.User {
 	}
 }
 
+// helper function returns the token type based on the
+// token prefix.
+func convertTokenType(token string) string {
+	switch {
+	case strings.HasPrefix(token, "ghp_"):
+		return "personal"
+	case strings.HasPrefix(token, "github_pat_"):
+		return "fine-grained"
+	case strings.HasPrefix(token, "gho_"):
+		return "oauth"
+	case strings.HasPrefix(token, "ghu_"):
+		return "user-to-server"
+	case strings.HasPrefix(token, "ghs_"):
+		return "installation"
+	default:
+		return ""
+	}
+}
+
+// helper function splits the comma separated scopes
+// header values.
+func splitScopes(values []string) []string {
+	scopes := []string{}
+	for _, value := range values {
+		for _, scope := range strings.Split(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes
+}
+
+// helper function parses the token expiration header,
+// for example 2023-02-22 21:18:05 UTC.
+func parseTokenExpiration(value string) time.Time {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05 MST",
+		"2006-01-02 15:04:05 -0700",
+	} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 func returnPrimaryEmail(from []*scm.Email) string {
 	for _, v := range from {
 		if v.Primary == true {
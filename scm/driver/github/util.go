@@ -146,3 +146,36 @@ func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
 	}
 	return params.Encode()
 }
+
+// encodeHookDeliveryListOptions encodes the page size. The
+// deliveries are paged with a cursor, so the page number is
+// not encoded.
+func encodeHookDeliveryListOptions(opts scm.ListOptions) string {
+	params := url.Values{}
+	if opts.Size != 0 {
+		params.Set("per_page", strconv.Itoa(opts.Size))
+	}
+	return params.Encode()
+}
+
+// nextCursorURL returns the rel="next" url from the Link
+// header of a cursor paged response, or an empty string if
+// there is no next page.
+func nextCursorURL(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		segments := strings.Split(strings.TrimSpace(link), ";")
+		if len(segments) < 2 {
+			continue
+		}
+		target := strings.TrimSpace(segments[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		for _, segment := range segments[1:] {
+			if strings.TrimSpace(segment) == `rel="next"` {
+				return target[1 : len(target)-1]
+			}
+		}
+	}
+	return ""
+}
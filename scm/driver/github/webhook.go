@@ -42,8 +42,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePullRequestHook(data)
 	case "deployment":
 		hook, err = s.parseDeploymentHook(data)
-	// case "pull_request_review_comment":
-	// case "issues":
+	case "pull_request_review_comment":
+		hook, err = s.parseReviewCommentHook(data)
+	case "issues":
+		hook, err = s.parseIssueHook(data)
 	case "issue_comment":
 		hook, err = s.parseIssueCommentHook(data)
 	case "release":
@@ -60,7 +62,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parseMergeGroupHook(data)
 
 	default:
-		return nil, scm.ErrUnknownEvent
+		err = scm.ErrUnknownEvent
+	}
+	if err == scm.ErrUnknownEvent && scm.RawHooksEnabled(req.Context()) {
+		hook, err = s.parseRawHook(req.Header.Get("X-GitHub-Event"), data)
 	}
 	if err != nil {
 		return nil, err
@@ -135,6 +140,44 @@ func (s *webhookService) parseDeploymentHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parseIssueHook(data []byte) (scm.Webhook, error) {
+	src := new(issueHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertIssueHook(src)
+	switch src.Action {
+	case "opened":
+		dst.Action = scm.ActionOpen
+	case "edited":
+		dst.Action = scm.ActionUpdate
+	case "deleted":
+		dst.Action = scm.ActionDelete
+	case "closed":
+		dst.Action = scm.ActionClose
+	case "reopened":
+		dst.Action = scm.ActionReopen
+	case "labeled":
+		dst.Action = scm.ActionLabel
+	case "unlabeled":
+		dst.Action = scm.ActionUnlabel
+	case "assigned":
+		dst.Action = scm.ActionAssign
+	case "unassigned":
+		dst.Action = scm.ActionUnassign
+	case "milestoned":
+		dst.Action = scm.ActionMilestone
+	case "demilestoned":
+		dst.Action = scm.ActionDemilestone
+	case "transferred":
+		dst.Action = scm.ActionTransfer
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst, nil
+}
+
 func (s *webhookService) parseIssueCommentHook(data []byte) (scm.Webhook, error) {
 	src := new(issueCommentHook)
 	err := json.Unmarshal(data, src)
@@ -213,6 +256,26 @@ func (s *webhookService) parsePullRequestReviewHook(data []byte) (scm.Webhook, e
 	return dst, nil
 }
 
+func (s *webhookService) parseReviewCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(reviewCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertReviewCommentHook(src)
+	switch src.Action {
+	case "created":
+		dst.Action = scm.ActionCreate
+	case "edited":
+		dst.Action = scm.ActionEdit
+	case "deleted":
+		dst.Action = scm.ActionDelete
+	default:
+		dst.Action = scm.ActionUnknown
+	}
+	return dst, nil
+}
+
 func (s *webhookService) parsePipelineHook(data []byte) (scm.Webhook, error) {
 	src := new(pipelineHook)
 	err := json.Unmarshal(data, src)
@@ -399,6 +462,15 @@ func (s *webhookService) parseReleaseHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	src := new(rawHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawHook(event, src, data), nil
+}
+
 //
 // native data structures
 //
@@ -507,6 +579,43 @@ type (
 		Sender     user       `json:"sender"`
 	}
 
+	// github issues webhook payload
+	issueHook struct {
+		Action     string     `json:"action"`
+		Issue      issue      `json:"issue"`
+		Repository repository `json:"repository"`
+		Sender     user       `json:"sender"`
+	}
+
+	// github pull_request_review_comment webhook payload
+	reviewCommentHook struct {
+		Action      string     `json:"action"`
+		Comment     review     `json:"comment"`
+		PullRequest pr         `json:"pull_request"`
+		Repository  repository `json:"repository"`
+		Sender      user       `json:"sender"`
+	}
+
+	// generic payload used to extract the repository and
+	// sender from events without a dedicated hook type.
+	rawHook struct {
+		Action     string `json:"action"`
+		Repository *struct {
+			ID    int64 `json:"id"`
+			Owner struct {
+				Login string `json:"login"`
+			} `json:"owner"`
+			Name          string `json:"name"`
+			Private       bool   `json:"private"`
+			Visibility    string `json:"visibility"`
+			HTMLURL       string `json:"html_url"`
+			SSHURL        string `json:"ssh_url"`
+			CloneURL      string `json:"clone_url"`
+			DefaultBranch string `json:"default_branch"`
+		} `json:"repository"`
+		Sender *user `json:"sender"`
+	}
+
 	// github issue_comment webhook payload
 	issueCommentHook struct {
 		Action       string     `json:"action"`
@@ -849,6 +958,23 @@ func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
 	return dst
 }
 
+func convertIssueHook(src *issueHook) *scm.IssueHook {
+	return &scm.IssueHook{
+		Repo:   *convertRepository(&src.Repository),
+		Issue:  *convertIssue(&src.Issue),
+		Sender: *convertUser(&src.Sender),
+	}
+}
+
+func convertReviewCommentHook(src *reviewCommentHook) *scm.ReviewCommentHook {
+	return &scm.ReviewCommentHook{
+		Repo:        *convertRepository(&src.Repository),
+		PullRequest: *convertPullRequest(&src.PullRequest),
+		Review:      *convertReview(&src.Comment),
+		Sender:      *convertUser(&src.Sender),
+	}
+}
+
 func convertIssueCommentHook(src *issueCommentHook) *scm.IssueCommentHook {
 	dst := &scm.IssueCommentHook{
 		Repo: scm.Repository{
@@ -905,6 +1031,33 @@ func convertReleaseHook(src *releaseHook) *scm.ReleaseHook {
 	return dst
 }
 
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event:  event,
+		Action: src.Action,
+		Data:   data,
+	}
+	// the repository timestamps are not decoded since their
+	// format is not consistent across event types.
+	if src.Repository != nil {
+		dst.Repo = scm.Repository{
+			ID:         fmt.Sprint(src.Repository.ID),
+			Namespace:  src.Repository.Owner.Login,
+			Name:       src.Repository.Name,
+			Branch:     src.Repository.DefaultBranch,
+			Private:    src.Repository.Private,
+			Visibility: scm.ConvertVisibility(src.Repository.Visibility),
+			Clone:      src.Repository.CloneURL,
+			CloneSSH:   src.Repository.SSHURL,
+			Link:       src.Repository.HTMLURL,
+		}
+	}
+	if src.Sender != nil {
+		dst.Sender = *convertUser(src.Sender)
+	}
+	return dst
+}
+
 // regexp help determine if the named git object is a tag.
 // this is not meant to be 100% accurate.
 var tagRE = regexp.MustCompile("^v?(\\d+).(.+)")
@@ -93,6 +93,48 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/comment.json.golden",
 			obj:    new(scm.IssueCommentHook),
 		},
+		// pull_request_review_comment
+		{
+			event:  "pull_request_review_comment",
+			before: "testdata/webhooks/pr_review_comment_created.json",
+			after:  "testdata/webhooks/pr_review_comment_created.json.golden",
+			obj:    new(scm.ReviewCommentHook),
+		},
+
+		//
+		// issue events
+		//
+
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_opened.json",
+			after:  "testdata/webhooks/issues_opened.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_labeled.json",
+			after:  "testdata/webhooks/issues_labeled.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_assigned.json",
+			after:  "testdata/webhooks/issues_assigned.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_milestoned.json",
+			after:  "testdata/webhooks/issues_milestoned.json.golden",
+			obj:    new(scm.IssueHook),
+		},
+		{
+			event:  "issues",
+			before: "testdata/webhooks/issues_transferred.json",
+			after:  "testdata/webhooks/issues_transferred.json.golden",
+			obj:    new(scm.IssueHook),
+		},
 
 		//
 		// tag events
@@ -353,6 +395,54 @@ func TestWebhook_ErrUnknownEvent(t *testing.T) {
 	}
 }
 
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+	r.Header.Set("X-GitHub-Event", "discussion")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r.Header.Set("X-Hub-Signature-256", "sha256=e3bfe744d4e2e29ed990bde8acfb8255ca51ef65f99657767989fb6349f32957")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "discussion"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Namespace+"/"+hook.Repo.Name, "Codertocat/Hello-World"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "Codertocat"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw payload preserved")
+	}
+}
+
+func TestWebhookRawHookSignatureInvalid(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+	r.Header.Set("X-GitHub-Event", "discussion")
+	r.Header.Set("X-GitHub-Delivery", "ee8d97b4-1479-43f1-9cac-fbbd1b80da55")
+	r.Header.Set("X-Hub-Signature-256", "sha256=3bfbbc3bfc44498db2254f577b2e4bed201ece6163518ba91cb2c21f0f59d512")
+
+	s := new(webhookService)
+	_, err := s.Parse(r, secretFunc)
+	if err != scm.ErrSignatureInvalid {
+		t.Errorf("Expect invalid signature error, got %v", err)
+	}
+}
+
 func TestWebhookInvalid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/push.json")
 	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
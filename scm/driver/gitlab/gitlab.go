@@ -41,6 +41,7 @@ func New(uri string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Capabilities = scm.CopyCapabilities(capabilities)
 	return client.Client, nil
 }
 
@@ -51,6 +52,14 @@ func NewDefault() *scm.Client {
 	return client
 }
 
+// capabilities maps each capability supported by the
+// driver to the minimum GitLab version.
+var capabilities = map[scm.Capability]string{
+	scm.CapabilityDraftPullRequests: "13.2",
+	scm.CapabilityHookDeliveries:    "17.4",
+	scm.CapabilityTokenInfo:         "",
+}
+
 // wraper wraps the Client to provide high level helper functions
 // for making http requests and unmarshaling the response.
 type wrapper struct {
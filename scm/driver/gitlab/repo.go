@@ -6,7 +6,9 @@ package gitlab
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -62,6 +64,18 @@ type hook struct {
 	CreatedAt             time.Time `json:"created_at"`
 }
 
+type hookEvent struct {
+	ID                int               `json:"id"`
+	URL               string            `json:"url"`
+	Trigger           string            `json:"trigger"`
+	RequestHeaders    map[string]string `json:"request_headers"`
+	RequestData       json.RawMessage   `json:"request_data"`
+	ResponseHeaders   map[string]string `json:"response_headers"`
+	ResponseBody      string            `json:"response_body"`
+	ExecutionDuration float64           `json:"execution_duration"`
+	ResponseStatus    string            `json:"response_status"`
+}
+
 type repositoryService struct {
 	client *wrapper
 }
@@ -187,6 +201,54 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	// gitlab does not provide an endpoint to fetch a single
+	// hook event, so we page through the recent hook events
+	// until we find a match. The scan is limited to the most
+	// recent hook events, which costs up to hookEventPages
+	// requests.
+	var res *scm.Response
+	opts := scm.ListOptions{Page: 1, Size: 100}
+	for i := 0; i < hookEventPages; i++ {
+		out, r, err := s.ListHookDeliveries(ctx, repo, id, opts)
+		res = r
+		if err != nil {
+			return nil, res, err
+		}
+		for _, v := range out {
+			if v.ID == delivery {
+				return v, res, nil
+			}
+		}
+		if res.Page.Next == 0 {
+			return nil, res, scm.ErrNotFound
+		}
+		opts.Page = res.Page.Next
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+// hookEventPages is the maximum number of hook event pages,
+// of 100 events each, scanned by FindHookDelivery.
+const hookEventPages = 10
+
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events?%s", encode(repo), id, encodeListOptions(opts))
+	out := []*hookEvent{}
+	res, err := s.client.do(ctx, "GET", path, nil, &out)
+	return convertHookEventList(out), res, err
+}
+
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/events/%s/resend", encode(repo), id, delivery)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	path := fmt.Sprintf("api/v4/projects/%s/hooks/%s/test/push_events", encode(repo), id)
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
 // helper function to convert from the gitlab repository list to
 // the common repository structure.
 func convertRepositoryList(from []*repository) []*scm.Repository {
@@ -246,6 +308,55 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertHookEventList(from []*hookEvent) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range from {
+		to = append(to, convertHookEvent(v))
+	}
+	return to
+}
+
+func convertHookEvent(from *hookEvent) *scm.HookDelivery {
+	to := &scm.HookDelivery{
+		ID:       strconv.Itoa(from.ID),
+		Event:    from.Trigger,
+		Status:   from.ResponseStatus,
+		Duration: time.Duration(from.ExecutionDuration * float64(time.Second)),
+		Request: scm.HookMessage{
+			Header: convertHookHeaders(from.RequestHeaders),
+		},
+		Response: scm.HookMessage{
+			Header: convertHookHeaders(from.ResponseHeaders),
+			Body:   from.ResponseBody,
+		},
+	}
+	if len(from.RequestData) != 0 && string(from.RequestData) != "null" {
+		to.Request.Body = string(from.RequestData)
+	}
+	if to.Request.Header != nil {
+		to.GUID = to.Request.Header.Get("X-Gitlab-Event-UUID")
+		if event := to.Request.Header.Get("X-Gitlab-Event"); event != "" {
+			to.Event = event
+		}
+	}
+	// the response status is a string that contains either
+	// the http status code or an error message.
+	to.StatusCode, _ = strconv.Atoi(from.ResponseStatus)
+	to.Success = to.StatusCode >= 200 && to.StatusCode < 300
+	return to
+}
+
+func convertHookHeaders(from map[string]string) http.Header {
+	if len(from) == 0 {
+		return nil
+	}
+	to := http.Header{}
+	for k, v := range from {
+		to.Set(k, v)
+	}
+	return to
+}
+
 type status struct {
 	Name    string      `json:"name"`
 	Desc    null.String `json:"description"`
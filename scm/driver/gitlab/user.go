@@ -7,7 +7,9 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 	"github.com/drone/go-scm/scm/driver/internal/null"
@@ -23,6 +25,25 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertUser(out), res, err
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	user, res, err := s.Find(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	out := new(personalAccessToken)
+	res, err = s.client.do(ctx, "GET", "api/v4/personal_access_tokens/self", nil, out)
+	if err == nil {
+		return convertPersonalAccessToken(out, user), res, nil
+	}
+	// oauth tokens are not personal access tokens, in which
+	// case we fallback to the oauth token info endpoint.
+	info := new(oauthTokenInfo)
+	if res, err := s.client.do(ctx, "GET", "oauth/token/info", nil, info); err == nil {
+		return convertOAuthTokenInfo(info, user), res, nil
+	}
+	return nil, res, err
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("api/v4/users?search=%s", login)
 	out := []*user{}
@@ -56,6 +77,19 @@ type user struct {
 	Avatar   string      `json:"avatar_url"`
 }
 
+type personalAccessToken struct {
+	ID        int         `json:"id"`
+	Name      string      `json:"name"`
+	Scopes    []string    `json:"scopes"`
+	ExpiresAt null.String `json:"expires_at"`
+}
+
+type oauthTokenInfo struct {
+	Scopes    []string `json:"scopes"`
+	ExpiresIn int64    `json:"expires_in"`
+	CreatedAt int64    `json:"created_at"`
+}
+
 type email struct {
 	Email     string      `json:"email"`
 	Confirmed null.String `json:"confirmed_at"`
@@ -72,6 +106,46 @@ func convertUser(from *user) *scm.User {
 	}
 }
 
+// helper function to convert from the gitlab personal access
+// token structure to the common token info structure.
+func convertPersonalAccessToken(from *personalAccessToken, user *scm.User) *scm.TokenInfo {
+	to := &scm.TokenInfo{
+		ID:     strconv.Itoa(from.ID),
+		Name:   from.Name,
+		Type:   "personal",
+		Scopes: from.Scopes,
+		User:   *user,
+	}
+	if to.Scopes == nil {
+		to.Scopes = []string{}
+	}
+	// the expiry is a calendar date, and the token expires
+	// at midnight utc on that date.
+	if t, err := time.Parse("2006-01-02", from.ExpiresAt.String); err == nil {
+		to.Expires = t
+	}
+	return to
+}
+
+// helper function to convert from the gitlab oauth token info
+// structure to the common token info structure.
+func convertOAuthTokenInfo(from *oauthTokenInfo, user *scm.User) *scm.TokenInfo {
+	to := &scm.TokenInfo{
+		Type:   "oauth",
+		Scopes: from.Scopes,
+		User:   *user,
+	}
+	if to.Scopes == nil {
+		to.Scopes = []string{}
+	}
+	if from.ExpiresIn != 0 {
+		to.Expires = time.Now().Add(
+			time.Duration(from.ExpiresIn) * time.Second,
+		)
+	}
+	return to
+}
+
 // helper function to convert from the gitlab email list to
 // the common email structure.
 func convertEmailList(from []*email) []*scm.Email {
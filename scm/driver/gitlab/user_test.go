@@ -49,6 +49,86 @@ func TestUserFind(t *testing.T) {
 	t.Run("Rate", testRate(res))
 }
 
+func TestUserFindTokenInfo(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/personal_access_tokens/self").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/token_self.json")
+
+	client := NewDefault()
+	got, res, err := client.Users.FindTokenInfo(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.TokenInfo)
+	raw, _ := ioutil.ReadFile("testdata/token_self.json.golden")
+	json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	t.Run("Request", testRequest(res))
+	t.Run("Rate", testRate(res))
+}
+
+func TestUserFindTokenInfo_OAuth(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/user").
+		Reply(200).
+		Type("application/json").
+		SetHeaders(mockHeaders).
+		File("testdata/user.json")
+
+	gock.New("https://gitlab.com").
+		Get("/api/v4/personal_access_tokens/self").
+		Reply(401).
+		Type("application/json").
+		BodyString(`{"message":"401 Unauthorized"}`)
+
+	gock.New("https://gitlab.com").
+		Get("/oauth/token/info").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"resource_owner_id":1,"scope":["api"],"scopes":["api"],"expires_in":7200,"application":{"uid":"1cb242f495280beb4291e64bee2a17f330902e499882fe8e1e2aa875519cab33"},"created_at":1575890427}`)
+
+	client := NewDefault()
+	got, _, err := client.Users.FindTokenInfo(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := got.Type, "oauth"; got != want {
+		t.Errorf("Want token type %q, got %q", want, got)
+	}
+	if diff := cmp.Diff(got.Scopes, []string{"api"}); diff != "" {
+		t.Errorf("Unexpected Scopes")
+		t.Log(diff)
+	}
+	if got.Expires.IsZero() {
+		t.Errorf("Expect token expiry set")
+	}
+	if got, want := got.User.Login, "john_smith"; got != want {
+		t.Errorf("Want token user %q, got %q", want, got)
+	}
+}
+
 func TestUserLoginFind(t *testing.T) {
 	defer gock.Off()
 
@@ -10,6 +10,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"strconv"
 	"time"
 
@@ -30,11 +31,12 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	}
 
 	var hook scm.Webhook
-	switch req.Header.Get("X-Gitlab-Event") {
+	event := req.Header.Get("X-Gitlab-Event")
+	switch event {
 	case "Push Hook", "Tag Push Hook":
 		hook, err = parsePushHook(data)
 	case "Issue Hook":
-		return nil, scm.ErrUnknownEvent
+		err = scm.ErrUnknownEvent
 	case "Merge Request Hook":
 		hook, err = parsePullRequestHook(data)
 	case "Note Hook":
@@ -43,8 +45,19 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = parseSystemHook(data)
 	case "Pipeline Hook":
 		return parsePipelineHook(data)
+	case "Release Hook":
+		hook, err = parseReleaseHook(data)
+	case "Deployment Hook":
+		hook, err = parseDeploymentHook(data)
+	case "Job Hook":
+		hook, err = parseJobHook(data)
+	case "Feature Flag Hook", "Wiki Page Hook":
+		hook, err = parseRawHook(event, data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		err = scm.ErrUnknownEvent
+	}
+	if err == scm.ErrUnknownEvent && scm.RawHooksEnabled(req.Context()) {
+		hook, err = parseRawHook(event, data)
 	}
 	if err != nil {
 		return nil, err
@@ -110,6 +123,44 @@ func parsePipelineHook(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func parseReleaseHook(data []byte) (scm.Webhook, error) {
+	src := new(releaseHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertReleaseHook(src), nil
+}
+
+func parseDeploymentHook(data []byte) (scm.Webhook, error) {
+	src := new(deploymentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertDeploymentHook(src), nil
+}
+
+func parseJobHook(data []byte) (scm.Webhook, error) {
+	src := new(jobHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertJobHook(src), nil
+}
+
+// parseRawHook returns the payload of events that have no
+// dedicated hook type, eg feature flag and wiki page events.
+func parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	src := new(genericHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawHook(event, src, data), nil
+}
+
 func parsePushHook(data []byte) (scm.Webhook, error) {
 	src := new(pushHook)
 	err := json.Unmarshal(data, src)
@@ -426,10 +477,121 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 func parseTimeString(timeString string) time.Time {
 	layout := "2006-01-02 15:04:05 UTC"
 	// Returns zero value of time in case of an error 0001-01-01 00:00:00 +0000 UTC
-	t, _ := time.Parse(layout, timeString)
+	t, err := time.Parse(layout, timeString)
+	if err != nil {
+		// newer gitlab versions send some hook timestamps
+		// (eg job hooks) in iso8601 format.
+		t, _ = time.Parse(time.RFC3339, timeString)
+	}
 	return t
 }
 
+func convertReleaseHook(src *releaseHook) *scm.ReleaseHook {
+	action := scm.ActionUnknown
+	switch src.Action {
+	case "create":
+		action = scm.ActionCreate
+	case "update":
+		action = scm.ActionUpdate
+	case "delete":
+		action = scm.ActionDelete
+	}
+	return &scm.ReleaseHook{
+		Action: action,
+		Release: scm.Release{
+			ID:          src.ID,
+			Title:       src.Name,
+			Description: src.Description,
+			Link:        src.URL,
+			Tag:         src.Tag,
+			Commitish:   src.Commit.ID,
+			Created:     parseTimeString(src.CreatedAt),
+			Published:   parseTimeString(src.ReleasedAt),
+		},
+		Repo: *convertHookProject(&src.Project),
+		// gitlab does not include the user that created
+		// the release in the payload.
+	}
+}
+
+func convertDeploymentHook(src *deploymentHook) *scm.DeployHook {
+	dst := &scm.DeployHook{
+		Number: int64(src.DeploymentID),
+		Desc:   src.CommitTitle,
+		// gitlab sends a deployment hook for every status
+		// change and has no custom payload, so the status
+		// (running, success, failed, canceled) is exposed
+		// as the deployment data.
+		Data: src.Status,
+		Ref: scm.Reference{
+			Name: src.Ref,
+			Path: src.Ref,
+			// the deployment payload only includes the short sha,
+			// the full sha is the last segment of the commit url.
+			Sha: path.Base(src.CommitURL),
+		},
+		Repo:      *convertHookProject(&src.Project),
+		Sender:    *convertUser(&src.User),
+		Target:    src.Environment,
+		TargetURL: src.EnvironmentExternalURL,
+		Task:      "deploy",
+	}
+	if src.Ref == "" {
+		return dst
+	}
+	if src.Tag {
+		dst.Ref.Path = scm.ExpandRef(dst.Ref.Path, "refs/tags/")
+	} else {
+		dst.Ref.Path = scm.ExpandRef(dst.Ref.Path, "refs/heads/")
+	}
+	return dst
+}
+
+func convertJobHook(src *jobHook) *scm.CheckHook {
+	check := scm.Check{
+		Name:       src.BuildName,
+		Status:     scm.ConvertExecutionStatus(src.BuildStatus),
+		Conclusion: src.BuildStatus,
+		Sha:        src.Sha,
+		Started:    parseTimeString(src.BuildStartedAt.String),
+		Completed:  parseTimeString(src.BuildFinishedAt.String),
+	}
+	if src.Project.WebURL != "" {
+		check.TargetURL = fmt.Sprintf("%s/-/jobs/%d", src.Project.WebURL, src.BuildID)
+	}
+	return &scm.CheckHook{
+		Checks: []scm.Check{check},
+		Repo:   *convertHookProject(&src.Project),
+		Sender: *convertUser(&src.User),
+	}
+}
+
+// convertRawHook returns the raw hook. The event is the
+// X-Gitlab-Event header, consistent with the other drivers,
+// rather than the payload object kind.
+func convertRawHook(event string, src *genericHook, data []byte) *scm.RawHook {
+	return &scm.RawHook{
+		Event:  event,
+		Action: src.ObjectAttributes.Action,
+		Repo:   *convertHookProject(&src.Project),
+		Sender: *convertUser(&src.User),
+		Data:   data,
+	}
+}
+
+func convertHookProject(src *hookProject) *scm.Repository {
+	namespace, name := scm.SplitWithStrategy(src.PathWithNamespace, scm.SplitLastSeparator)
+	return &scm.Repository{
+		ID:        strconv.Itoa(src.ID),
+		Namespace: namespace,
+		Name:      name,
+		Clone:     src.GitHTTPURL,
+		CloneSSH:  src.GitSSHURL,
+		Link:      src.WebURL,
+		Branch:    src.DefaultBranch,
+	}
+}
+
 func convertPipelineHook(src *pipelineHook) *scm.PipelineHook {
 	namespace, name := scm.SplitWithStrategy(src.Project.PathWithNamespace, scm.SplitLastSeparator)
 	return &scm.PipelineHook{
@@ -1037,6 +1199,95 @@ type (
 		Builds []build `json:"builds"`
 	}
 
+	releaseHook struct {
+		ObjectKind  string      `json:"object_kind"`
+		ID          int         `json:"id"`
+		Action      string      `json:"action"`
+		Name        string      `json:"name"`
+		Description string      `json:"description"`
+		Tag         string      `json:"tag"`
+		URL         string      `json:"url"`
+		CreatedAt   string      `json:"created_at"`
+		ReleasedAt  string      `json:"released_at"`
+		Project     hookProject `json:"project"`
+		Commit      struct {
+			ID        string `json:"id"`
+			Message   string `json:"message"`
+			Title     string `json:"title"`
+			Timestamp string `json:"timestamp"`
+			URL       string `json:"url"`
+			Author    author `json:"author"`
+		} `json:"commit"`
+	}
+
+	deploymentHook struct {
+		ObjectKind             string      `json:"object_kind"`
+		Status                 string      `json:"status"`
+		StatusChangedAt        string      `json:"status_changed_at"`
+		DeploymentID           int         `json:"deployment_id"`
+		DeployableID           int         `json:"deployable_id"`
+		DeployableURL          string      `json:"deployable_url"`
+		Environment            string      `json:"environment"`
+		EnvironmentTier        string      `json:"environment_tier"`
+		EnvironmentSlug        string      `json:"environment_slug"`
+		EnvironmentExternalURL string      `json:"environment_external_url"`
+		Project                hookProject `json:"project"`
+		ShortSha               string      `json:"short_sha"`
+		User                   user        `json:"user"`
+		UserURL                string      `json:"user_url"`
+		CommitURL              string      `json:"commit_url"`
+		CommitTitle            string      `json:"commit_title"`
+		Ref                    string      `json:"ref"`
+		Tag                    bool        `json:"tag"`
+	}
+
+	jobHook struct {
+		ObjectKind         string      `json:"object_kind"`
+		Ref                string      `json:"ref"`
+		Tag                bool        `json:"tag"`
+		BeforeSha          string      `json:"before_sha"`
+		Sha                string      `json:"sha"`
+		BuildID            int         `json:"build_id"`
+		BuildName          string      `json:"build_name"`
+		BuildStage         string      `json:"build_stage"`
+		BuildStatus        string      `json:"build_status"`
+		BuildCreatedAt     string      `json:"build_created_at"`
+		BuildStartedAt     null.String `json:"build_started_at"`
+		BuildFinishedAt    null.String `json:"build_finished_at"`
+		BuildAllowFailure  bool        `json:"build_allow_failure"`
+		BuildFailureReason string      `json:"build_failure_reason"`
+		PipelineID         int         `json:"pipeline_id"`
+		ProjectID          int         `json:"project_id"`
+		ProjectName        string      `json:"project_name"`
+		User               user        `json:"user"`
+		Project            hookProject `json:"project"`
+	}
+
+	// generic payload used to extract the repository and
+	// sender from events without a dedicated hook type.
+	genericHook struct {
+		ObjectKind       string `json:"object_kind"`
+		ObjectAttributes struct {
+			Action string `json:"action"`
+		} `json:"object_attributes"`
+		User    user        `json:"user"`
+		Project hookProject `json:"project"`
+	}
+
+	hookProject struct {
+		ID                int         `json:"id"`
+		Name              string      `json:"name"`
+		Description       string      `json:"description"`
+		WebURL            string      `json:"web_url"`
+		AvatarURL         null.String `json:"avatar_url"`
+		GitSSHURL         string      `json:"git_ssh_url"`
+		GitHTTPURL        string      `json:"git_http_url"`
+		Namespace         string      `json:"namespace"`
+		VisibilityLevel   int         `json:"visibility_level"`
+		PathWithNamespace string      `json:"path_with_namespace"`
+		DefaultBranch     string      `json:"default_branch"`
+	}
+
 	variable struct {
 		Key   string `json:"key"`
 		Value string `json:"value"`
@@ -243,6 +243,27 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pipeline_subgroup.json.golden",
 			obj:    new(scm.PipelineHook),
 		},
+		// release hooks
+		{
+			event:  "Release Hook",
+			before: "testdata/webhooks/release_create.json",
+			after:  "testdata/webhooks/release_create.json.golden",
+			obj:    new(scm.ReleaseHook),
+		},
+		// deployment hooks
+		{
+			event:  "Deployment Hook",
+			before: "testdata/webhooks/deployment.json",
+			after:  "testdata/webhooks/deployment.json.golden",
+			obj:    new(scm.DeployHook),
+		},
+		// job hooks
+		{
+			event:  "Job Hook",
+			before: "testdata/webhooks/job.json",
+			after:  "testdata/webhooks/job.json.golden",
+			obj:    new(scm.CheckHook),
+		},
 	}
 
 	for _, test := range tests {
@@ -303,6 +324,57 @@ func TestWebhooks(t *testing.T) {
 	}
 }
 
+func TestWebhookRawHook(t *testing.T) {
+	tests := []struct {
+		event  string
+		file   string
+		action string
+	}{
+		{
+			event:  "Wiki Page Hook",
+			file:   "testdata/webhooks/wiki_page.json",
+			action: "create",
+		},
+		{
+			event: "Feature Flag Hook",
+			file:  "testdata/webhooks/feature_flag.json",
+		},
+	}
+	for _, test := range tests {
+		f, _ := ioutil.ReadFile(test.file)
+		r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+		r.Header.Set("X-Gitlab-Event", test.event)
+		r.Header.Set("X-Gitlab-Token", "topsecret")
+
+		s := new(webhookService)
+		o, err := s.Parse(r, secretFunc)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		hook, ok := o.(*scm.RawHook)
+		if !ok {
+			t.Errorf("Expect raw hook for %s, got %T", test.event, o)
+			continue
+		}
+		if got, want := hook.Event, test.event; got != want {
+			t.Errorf("Want event %q, got %q", want, got)
+		}
+		if got, want := hook.Action, test.action; got != want {
+			t.Errorf("Want action %q, got %q", want, got)
+		}
+		if got, want := hook.Repo.Namespace+"/"+hook.Repo.Name, "gitlab-org/example"; got != want {
+			t.Errorf("Want repository %q, got %q", want, got)
+		}
+		if got, want := hook.Sender.Login, "root"; got != want {
+			t.Errorf("Want sender %q, got %q", want, got)
+		}
+		if !bytes.Equal(hook.Data, f) {
+			t.Errorf("Want raw payload preserved")
+		}
+	}
+}
+
 func TestWebhook_SignatureValid(t *testing.T) {
 	f, _ := ioutil.ReadFile("testdata/webhooks/branch_delete.json")
 	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
@@ -348,3 +420,37 @@ func TestWebhook_SignatureMissing(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
+
+func TestWebhookRawHookOptIn(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/issue_create.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "Issue Hook")
+	r.Header.Set("X-Gitlab-Token", "topsecret")
+
+	s := new(webhookService)
+	if _, err := s.Parse(r, secretFunc); err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+
+	r, _ = http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gitlab-Event", "Issue Hook")
+	r.Header.Set("X-Gitlab-Token", "topsecret")
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	o, err := s.Parse(r, secretFunc)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "Issue Hook"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Action, "open"; got != want {
+		t.Errorf("Want action %q, got %q", want, got)
+	}
+}
@@ -231,3 +231,45 @@ func TestWebhookMissingSignature(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
+
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/issues_opened.json")
+	f = bytes.Replace(f, []byte(`"opened"`), []byte(`"published"`), 1)
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gogs-Event", "release")
+
+	s := new(webhookService)
+	if _, err := s.Parse(r, secretFunc); err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+
+	r, _ = http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Gogs-Event", "release")
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "release"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Action, "published"; got != want {
+		t.Errorf("Want action %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "hello-world"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "unknwon"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw payload preserved")
+	}
+}
@@ -40,9 +40,16 @@ func New(uri, account, organization, project string) (*scm.Client, error) {
 	client.Reviews = &reviewService{client}
 	client.Users = &userService{client}
 	client.Webhooks = &webhookService{client}
+	client.Capabilities = scm.CopyCapabilities(capabilities)
 	return client.Client, nil
 }
 
+// capabilities lists the capabilities supported by the
+// driver.
+var capabilities = map[scm.Capability]string{
+	scm.CapabilityDraftPullRequests: "",
+}
+
 // wraper wraps the Client to provide high level helper functions
 // for making http requests and unmarshaling the response.
 type wrapper struct {
@@ -303,6 +303,7 @@ func convertPullRequest(src *pr) *scm.PullRequest {
 		Source: src.SourceBranch,
 		Target: src.TargetBranch,
 		Merged: src.Merged.Valid,
+		Draft:  src.IsDraft,
 		Author: scm.User{
 			Login: src.Author.Email,
 			Name:  src.Author.DisplayName,
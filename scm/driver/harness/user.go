@@ -32,6 +32,10 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return convertHarnessUser(out), res, err
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
@@ -51,7 +51,10 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 	case "merge_queue_checks_requested", "merge_queue_checks_canceled":
 		hook, err = s.parseMergeQueueHook(data)
 	default:
-		return nil, scm.ErrUnknownEvent
+		err = scm.ErrUnknownEvent
+	}
+	if err == scm.ErrUnknownEvent && scm.RawHooksEnabled(req.Context()) {
+		hook, err = s.parseRawHook(req.Header.Get("X-Harness-Trigger"), data)
 	}
 	if err != nil {
 		return nil, err
@@ -126,6 +129,12 @@ func (s *webhookService) parseMergeQueueHook(data []byte) (scm.Webhook, error) {
 	return convertMergeQueueHook(dst), err
 }
 
+func (s *webhookService) parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	dst := new(rawHook)
+	err := json.Unmarshal(data, dst)
+	return convertRawHook(event, dst, data), err
+}
+
 // native data structures
 type (
 	repo struct {
@@ -243,6 +252,12 @@ type (
 		HeadCommit hookCommit `json:"head_commit"`
 		Comment    comment    `json:"comment"`
 	}
+	// generic payload used to extract the repository and
+	// sender from events without a dedicated hook type.
+	rawHook struct {
+		Repo      *repo      `json:"repo"`
+		Principal *principal `json:"principal"`
+	}
 	// harness merge queue webhook payload
 	mergeQueueHook struct {
 		Trigger   string    `json:"trigger"`
@@ -419,6 +434,20 @@ func convertPullReq(pr pullReq, ref ref, commit hookCommit) scm.PullRequest {
 	}
 }
 
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event: event,
+		Data:  data,
+	}
+	if src.Repo != nil {
+		dst.Repo = convertRepo(*src.Repo)
+	}
+	if src.Principal != nil {
+		dst.Sender = convertUser(*src.Principal)
+	}
+	return dst
+}
+
 func convertRepo(repo repo) scm.Repository {
 	return scm.Repository{
 		ID:     strconv.Itoa(repo.ID),
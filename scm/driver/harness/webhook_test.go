@@ -194,3 +194,42 @@ func TestWebhooks(t *testing.T) {
 func secretFunc(scm.Webhook) (string, error) {
 	return "topsecret", nil
 }
+
+func TestWebhookRawHook(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/pull_request_opened.json")
+	f = bytes.Replace(f, []byte(`"pullreq_created"`), []byte(`"pullreq_label_assigned"`), 1)
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Harness-Trigger", "pullreq_label_assigned")
+
+	s := new(webhookService)
+	if _, err := s.Parse(r, secretFunc); err != scm.ErrUnknownEvent {
+		t.Errorf("Expect unknown event error, got %v", err)
+	}
+
+	r, _ = http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Harness-Trigger", "pullreq_label_assigned")
+	r = r.WithContext(scm.WithRawHooks(r.Context()))
+
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect *scm.RawHook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "pullreq_label_assigned"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "aba"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "0osgWsTZRsSZ8RWfjLRkEg"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw payload preserved")
+	}
+}
@@ -2,42 +2,169 @@ package stash
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"github.com/drone/go-scm/scm"
 )
 
+// releaseService emulates releases, which bitbucket server
+// does not support, using annotated tags. The release title
+// and description are stored in the tag message, however the
+// tag message is not returned by the api, so the title of a
+// release is always the tag name. A tag has no numeric id, so
+// releases are identified by tag.
 type releaseService struct {
 	client *wrapper
 }
 
+// Find is not supported: releases are identified by tag.
 func (s *releaseService) Find(ctx context.Context, repo string, id int) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
 func (s *releaseService) FindByTag(ctx context.Context, repo string, tag string) (*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags/%s", namespace, name, url.PathEscape(tag))
+	out := new(branch)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if res != nil && res.Status == 404 {
+		return nil, res, scm.ErrNotFound
+	}
+	if err != nil {
+		return nil, res, err
+	}
+	return s.convertRelease(repo, out), res, nil
 }
 
+// List returns the releases, most recently modified first. A
+// tag has no state, so the open and closed options are ignored.
 func (s *releaseService) List(ctx context.Context, repo string, opts scm.ReleaseListOptions) ([]*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/tags?%s", namespace, name, encodeReleaseListOptions(opts))
+	out := new(branches)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	if err != nil {
+		return nil, res, err
+	}
+	copyPagination(out.pagination, res)
+	return s.convertReleaseList(repo, out), res, nil
 }
 
+// Create creates the release tag. A tag is always published,
+// so draft releases are not supported.
 func (s *releaseService) Create(ctx context.Context, repo string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	if input.Draft {
+		return nil, nil, scm.ErrNotSupported
+	}
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/git/1.0/projects/%s/repos/%s/tags", namespace, name)
+	in := &createTag{
+		Name:       input.Tag,
+		StartPoint: input.Commitish,
+		Message:    releaseMessage(input.Title, input.Description),
+	}
+	out := new(branch)
+	res, err := s.client.do(ctx, "POST", path, in, out)
+	if err != nil {
+		return nil, res, err
+	}
+	to := s.convertRelease(repo, out)
+	to.Title = input.Title
+	to.Description = input.Description
+	return to, res, nil
 }
 
+// Delete is not supported: releases are identified by tag.
 func (s *releaseService) Delete(ctx context.Context, repo string, id int) (*scm.Response, error) {
 	return nil, scm.ErrNotSupported
 }
 
 func (s *releaseService) DeleteByTag(ctx context.Context, repo string, tag string) (*scm.Response, error) {
-	return nil, scm.ErrNotSupported
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/git/1.0/projects/%s/repos/%s/tags/%s", namespace, name, url.PathEscape(tag))
+	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// Update is not supported: releases are identified by tag.
 func (s *releaseService) Update(ctx context.Context, repo string, id int, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
 	return nil, nil, scm.ErrNotSupported
 }
 
+// UpdateByTag updates the release. A tag cannot be modified,
+// so the tag is deleted and created again. If the tag is
+// renamed, the new tag is created before the old tag is
+// deleted. The existing tag message cannot be read, so the
+// tag message is replaced by the input title and description.
 func (s *releaseService) UpdateByTag(ctx context.Context, repo string, tag string, input *scm.ReleaseInput) (*scm.Release, *scm.Response, error) {
-	return nil, nil, scm.ErrNotSupported
+	if input.Draft {
+		return nil, nil, scm.ErrNotSupported
+	}
+	release, res, err := s.FindByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	update := *input
+	if update.Tag == "" {
+		update.Tag = release.Tag
+	}
+	if update.Commitish == "" {
+		update.Commitish = release.Commitish
+	}
+	if update.Tag != tag {
+		// the new tag is created before the old tag is
+		// deleted, so the release is not lost if the tag
+		// cannot be created.
+		out, res, err := s.Create(ctx, repo, &update)
+		if err != nil {
+			return nil, res, err
+		}
+		res, err = s.DeleteByTag(ctx, repo, tag)
+		if err != nil {
+			return nil, res, fmt.Errorf("release tag %s was created, however tag %s could not be deleted: %w", update.Tag, tag, err)
+		}
+		return out, res, nil
+	}
+	res, err = s.DeleteByTag(ctx, repo, tag)
+	if err != nil {
+		return nil, res, err
+	}
+	out, res, err := s.Create(ctx, repo, &update)
+	if err != nil {
+		return nil, res, fmt.Errorf("release tag %s was deleted and could not be created again: %w", tag, err)
+	}
+	return out, res, nil
+}
+
+type createTag struct {
+	Name       string `json:"name"`
+	StartPoint string `json:"startPoint"`
+	Message    string `json:"message,omitempty"`
+}
+
+// releaseMessage returns the tag message, which is the
+// release title followed by the description.
+func releaseMessage(title, description string) string {
+	if description == "" {
+		return title
+	}
+	return title + "\n\n" + description
+}
+
+func (s *releaseService) convertReleaseList(repo string, from *branches) []*scm.Release {
+	to := []*scm.Release{}
+	for _, v := range from.Values {
+		to = append(to, s.convertRelease(repo, v))
+	}
+	return to
+}
+
+func (s *releaseService) convertRelease(repo string, from *branch) *scm.Release {
+	namespace, name := scm.Split(repo)
+	return &scm.Release{
+		Title:     from.DisplayID,
+		Link:      fmt.Sprintf("%sprojects/%s/repos/%s/browse?at=%s", s.client.BaseURL, namespace, name, url.QueryEscape(from.ID)),
+		Tag:       from.DisplayID,
+		Commitish: from.LatestCommit,
+	}
 }
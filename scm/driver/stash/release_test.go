@@ -0,0 +1,299 @@
+// Copyright 2017 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stash
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
+)
+
+func TestReleaseFind(t *testing.T) {
+	_, _, err := NewDefault().Releases.Find(context.Background(), "PRJ/my-repo", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseFindByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Releases.FindByTag(context.Background(), "PRJ/my-repo", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want[0]); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseFindByTag_NotFound(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.0").
+		Reply(404).
+		Type("application/json").
+		File("testdata/error.json")
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Releases.FindByTag(context.Background(), "PRJ/my-repo", "v1.0")
+	if err != scm.ErrNotFound {
+		t.Errorf("Expect Not Found error, got %v", err)
+	}
+}
+
+func TestReleaseList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags").
+		MatchParam("limit", "25").
+		MatchParam("orderBy", "MODIFICATION").
+		Reply(200).
+		Type("application/json").
+		File("testdata/releases.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Releases.List(context.Background(), "PRJ/my-repo", scm.ReleaseListOptions{Page: 1, Size: 25})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.Release{}
+	raw, _ := ioutil.ReadFile("testdata/releases.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseCreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Post("/rest/git/1.0/projects/PRJ/repos/my-repo/tags").
+		JSON(map[string]string{
+			"name":       "v1.0.0",
+			"startPoint": "11ce869211917dd65610e70fcee454943b35ac6e",
+			"message":    "First release\n\nDescription of the release",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	input := &scm.ReleaseInput{
+		Title:       "First release",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+		Commitish:   "11ce869211917dd65610e70fcee454943b35ac6e",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Releases.Create(context.Background(), "PRJ/my-repo", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestReleaseCreate_Draft(t *testing.T) {
+	input := &scm.ReleaseInput{
+		Tag:   "v1.0.0",
+		Draft: true,
+	}
+	_, _, err := NewDefault().Releases.Create(context.Background(), "PRJ/my-repo", input)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseDelete(t *testing.T) {
+	_, err := NewDefault().Releases.Delete(context.Background(), "PRJ/my-repo", 1)
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseDeleteByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(204)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Releases.DeleteByTag(context.Background(), "PRJ/my-repo", "v1.0.0")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReleaseUpdate(t *testing.T) {
+	_, _, err := NewDefault().Releases.Update(context.Background(), "PRJ/my-repo", 1, &scm.ReleaseInput{})
+	if err != scm.ErrNotSupported {
+		t.Errorf("Expect Not Supported error")
+	}
+}
+
+func TestReleaseUpdateByTag(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(204)
+
+	// the commit of the existing release is kept.
+	gock.New("http://example.com:7990").
+		Post("/rest/git/1.0/projects/PRJ/repos/my-repo/tags").
+		JSON(map[string]string{
+			"name":       "v1.0.0",
+			"startPoint": "11ce869211917dd65610e70fcee454943b35ac6e",
+			"message":    "First release\n\nDescription of the release",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	input := &scm.ReleaseInput{
+		Title:       "First release",
+		Description: "Description of the release",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Releases.UpdateByTag(context.Background(), "PRJ/my-repo", "v1.0.0", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestReleaseUpdateByTag_Rename(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0-rc1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	// the new tag is created before the old tag is deleted.
+	gock.New("http://example.com:7990").
+		Post("/rest/git/1.0/projects/PRJ/repos/my-repo/tags").
+		JSON(map[string]string{
+			"name":       "v1.0.0",
+			"startPoint": "11ce869211917dd65610e70fcee454943b35ac6e",
+			"message":    "First release\n\nDescription of the release",
+		}).
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0-rc1").
+		Reply(204)
+
+	input := &scm.ReleaseInput{
+		Title:       "First release",
+		Description: "Description of the release",
+		Tag:         "v1.0.0",
+	}
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Releases.UpdateByTag(context.Background(), "PRJ/my-repo", "v1.0.0-rc1", input)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := new(scm.Release)
+	raw, _ := ioutil.ReadFile("testdata/release.json.golden")
+	_ = json.Unmarshal(raw, want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+
+	if !gock.IsDone() {
+		t.Errorf("Expect all requests to be made")
+	}
+}
+
+func TestReleaseUpdateByTag_Recreate(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(200).
+		Type("application/json").
+		File("testdata/release.json")
+
+	gock.New("http://example.com:7990").
+		Delete("/rest/git/1.0/projects/PRJ/repos/my-repo/tags/v1.0.0").
+		Reply(204)
+
+	gock.New("http://example.com:7990").
+		Post("/rest/git/1.0/projects/PRJ/repos/my-repo/tags").
+		Reply(500)
+
+	client, _ := New("http://example.com:7990")
+	_, _, err := client.Releases.UpdateByTag(context.Background(), "PRJ/my-repo", "v1.0.0", &scm.ReleaseInput{})
+	if err == nil || !strings.Contains(err.Error(), "v1.0.0 was deleted") {
+		t.Errorf("Expect deleted tag error, got %v", err)
+	}
+}
@@ -7,9 +7,12 @@ package stash
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/drone/go-scm/scm"
 )
@@ -75,6 +78,30 @@ type hook struct {
 	} `json:"configuration"`
 }
 
+type hookStatistics struct {
+	LastSuccess *hookInvocation `json:"lastSuccess"`
+	LastFailure *hookInvocation `json:"lastFailure"`
+	LastError   *hookInvocation `json:"lastError"`
+}
+
+type hookInvocation struct {
+	ID       int    `json:"id"`
+	Event    string `json:"event"`
+	Duration int64  `json:"duration"`
+	Start    int64  `json:"start"`
+	Finish   int64  `json:"finish"`
+	Request  struct {
+		URL     string            `json:"url"`
+		Method  string            `json:"method"`
+		Headers map[string]string `json:"headers"`
+		Body    string            `json:"body"`
+	} `json:"request"`
+	Result struct {
+		Description string `json:"description"`
+		Outcome     string `json:"outcome"`
+	} `json:"result"`
+}
+
 type hookInput struct {
 	Name   string   `json:"name"`
 	Events []string `json:"events"`
@@ -301,6 +328,52 @@ func (s *repositoryService) DeleteHook(ctx context.Context, repo string, id stri
 	return s.client.do(ctx, "DELETE", path, nil, nil)
 }
 
+// FindHookDelivery returns a repository webhook delivery.
+// Bitbucket Server only retains the most recent success,
+// failure and error invocations for each webhook.
+func (s *repositoryService) FindHookDelivery(ctx context.Context, repo, id, delivery string) (*scm.HookDelivery, *scm.Response, error) {
+	out, res, err := s.ListHookDeliveries(ctx, repo, id, scm.ListOptions{})
+	if err != nil {
+		return nil, res, err
+	}
+	for _, v := range out {
+		if v.ID == delivery {
+			return v, res, nil
+		}
+	}
+	return nil, res, scm.ErrNotFound
+}
+
+// ListHookDeliveries returns the most recent repository
+// webhook deliveries.
+func (s *repositoryService) ListHookDeliveries(ctx context.Context, repo, id string, opts scm.ListOptions) ([]*scm.HookDelivery, *scm.Response, error) {
+	namespace, name := scm.Split(repo)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/%s/statistics", namespace, name, id)
+	out := new(hookStatistics)
+	res, err := s.client.do(ctx, "GET", path, nil, out)
+	return convertHookStatistics(out), res, err
+}
+
+// RedeliverHook is not supported: bitbucket server does not
+// retain the request body of a webhook invocation.
+func (s *repositoryService) RedeliverHook(ctx context.Context, repo, id, delivery string) (*scm.Response, error) {
+	return nil, scm.ErrNotSupported
+}
+
+// PingHook sends a test request to a repository webhook.
+func (s *repositoryService) PingHook(ctx context.Context, repo, id string) (*scm.Response, error) {
+	hook, res, err := s.FindHook(ctx, repo, id)
+	if err != nil {
+		return res, err
+	}
+	namespace, name := scm.Split(repo)
+	params := url.Values{}
+	params.Set("webhookId", id)
+	params.Set("url", hook.Target)
+	path := fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/webhooks/test?%s", namespace, name, params.Encode())
+	return s.client.do(ctx, "POST", path, nil, nil)
+}
+
 // helper function to convert from the gogs repository list to
 // the common repository structure.
 func convertRepositoryList(from *repositories) []*scm.Repository {
@@ -369,6 +442,50 @@ func convertHook(from *hook) *scm.Hook {
 	}
 }
 
+func convertHookStatistics(from *hookStatistics) []*scm.HookDelivery {
+	to := []*scm.HookDelivery{}
+	for _, v := range []*hookInvocation{
+		from.LastSuccess,
+		from.LastFailure,
+		from.LastError,
+	} {
+		if v == nil || v.ID == 0 {
+			continue
+		}
+		to = append(to, convertHookInvocation(v))
+	}
+	// sort the invocations so that the most recent
+	// delivery is listed first.
+	sort.SliceStable(to, func(i, j int) bool {
+		return to[i].Created.After(to[j].Created)
+	})
+	return to
+}
+
+func convertHookInvocation(from *hookInvocation) *scm.HookDelivery {
+	to := &scm.HookDelivery{
+		ID:       strconv.Itoa(from.ID),
+		Event:    from.Event,
+		Success:  from.Result.Outcome == "SUCCESS",
+		Status:   from.Result.Description,
+		Duration: time.Duration(from.Duration) * time.Millisecond,
+		Created:  time.Unix(from.Start/1000, 0),
+		Request: scm.HookMessage{
+			Body: from.Request.Body,
+		},
+	}
+	// the result description typically contains the http
+	// status code returned by the remote server.
+	to.StatusCode, _ = strconv.Atoi(from.Result.Description)
+	if len(from.Request.Headers) != 0 {
+		to.Request.Header = http.Header{}
+		for k, v := range from.Request.Headers {
+			to.Request.Header.Set(k, v)
+		}
+	}
+	return to
+}
+
 func convertFromHookEvents(from scm.HookEvents) []string {
 	var events []string
 	if from.Push || from.Branch || from.Tag {
@@ -532,6 +532,76 @@ func TestRepositoryHookDelete(t *testing.T) {
 	}
 }
 
+func TestRepositoryHookDeliveryList(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/statistics").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_statistics.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.ListHookDeliveries(context.Background(), "PRJ/my-repo", "1", scm.ListOptions{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	want := []*scm.HookDelivery{}
+	raw, _ := ioutil.ReadFile("testdata/webhook_statistics.json.golden")
+	_ = json.Unmarshal(raw, &want)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Unexpected Results")
+		t.Log(diff)
+	}
+}
+
+func TestRepositoryHookDeliveryFind(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1/statistics").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook_statistics.json")
+
+	client, _ := New("http://example.com:7990")
+	got, _, err := client.Repositories.FindHookDelivery(context.Background(), "PRJ/my-repo", "1", "1093")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got.ID != "1093" || got.Success {
+		t.Errorf("Want failed delivery 1093, got %s", got.ID)
+	}
+}
+
+func TestRepositoryHookPing(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("http://example.com:7990").
+		Get("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/1").
+		Reply(200).
+		Type("application/json").
+		File("testdata/webhook.json")
+
+	gock.New("http://example.com:7990").
+		Post("/rest/api/1.0/projects/PRJ/repos/my-repo/webhooks/test").
+		MatchParam("webhookId", "1").
+		MatchParam("url", "http://example.com").
+		Reply(200).
+		Type("application/json").
+		BodyString(`{"request":{},"response":{"statusCode":200}}`)
+
+	client, _ := New("http://example.com:7990")
+	_, err := client.Repositories.PingHook(context.Background(), "PRJ/my-repo", "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestConvertFromState(t *testing.T) {
 	tests := []struct {
 		src scm.State
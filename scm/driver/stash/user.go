@@ -31,6 +31,10 @@ func (s *userService) Find(ctx context.Context) (*scm.User, *scm.Response, error
 	return s.FindLogin(ctx, login)
 }
 
+func (s *userService) FindTokenInfo(ctx context.Context) (*scm.TokenInfo, *scm.Response, error) {
+	return nil, nil, scm.ErrNotSupported
+}
+
 func (s *userService) FindLogin(ctx context.Context, login string) (*scm.User, *scm.Response, error) {
 	path := fmt.Sprintf("rest/api/1.0/users/%s", login)
 	out := new(user)
@@ -116,6 +116,21 @@ func encodePullRequestListOptions(opts scm.PullRequestListOptions) string {
 	return params.Encode()
 }
 
+func encodeReleaseListOptions(opts scm.ReleaseListOptions) string {
+	params := url.Values{}
+	if opts.Page > 1 {
+		params.Set("start", strconv.Itoa(
+			(opts.Page-1)*opts.Size),
+		)
+	}
+	if opts.Size != 0 {
+		params.Set("limit", strconv.Itoa(opts.Size))
+	}
+	// the most recently modified releases are listed first.
+	params.Set("orderBy", "MODIFICATION")
+	return params.Encode()
+}
+
 func copyPagination(from pagination, to *scm.Response) error {
 	if to == nil {
 		return nil
@@ -10,6 +10,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/drone/go-scm/scm"
@@ -43,6 +44,19 @@ func (s *webhookService) Parse(req *http.Request, fn scm.SecretFunc) (scm.Webhoo
 		hook, err = s.parsePushHook(data)
 	case "pr:opened", "pr:from_ref_updated", "pr:modified", "pr:declined", "pr:deleted", "pr:merged":
 		hook, err = s.parsePullRequest(data)
+	case "pr:comment:added", "pr:comment:edited", "pr:comment:deleted":
+		hook, err = s.parsePullRequestCommentHook(data)
+	case "pr:reviewer:approved", "pr:reviewer:unapproved", "pr:reviewer:needs_work":
+		hook, err = s.parsePullRequestReviewHook(data)
+	case "repo:comment:added", "repo:comment:edited", "repo:comment:deleted":
+		// there is no common commit comment type, so commit
+		// comments are always returned as a raw hook.
+		hook, err = s.parseRawHook(req.Header.Get("X-Event-Key"), data)
+	case "mirror:repo_synchronized":
+		hook, err = s.parsePushHook(data)
+	}
+	if hook == nil && err == nil && scm.RawHooksEnabled(req.Context()) {
+		hook, err = s.parseRawHook(req.Header.Get("X-Event-Key"), data)
 	}
 	if err != nil {
 		return nil, err
@@ -78,6 +92,11 @@ func (s *webhookService) parsePushHook(data []byte) (scm.Webhook, error) {
 	if len(dst.Changes) == 0 {
 		return nil, errors.New("Push hook has empty changeset")
 	}
+	if dst.Actor == nil {
+		// mirror:repo_synchronized events are triggered by
+		// the mirror server and do not include an actor.
+		dst.Actor = new(user)
+	}
 	change := dst.Changes[0]
 	switch {
 	case change.Ref.Type == "BRANCH" && change.Type != "UPDATE":
@@ -126,6 +145,49 @@ func (s *webhookService) parsePullRequest(data []byte) (scm.Webhook, error) {
 	return dst, nil
 }
 
+func (s *webhookService) parsePullRequestCommentHook(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestCommentHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPullRequestCommentHook(src)
+	switch src.EventKey {
+	case "pr:comment:added":
+		dst.Action = scm.ActionCreate
+	case "pr:comment:edited":
+		dst.Action = scm.ActionEdit
+	case "pr:comment:deleted":
+		dst.Action = scm.ActionDelete
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parsePullRequestReviewHook(data []byte) (scm.Webhook, error) {
+	src := new(pullRequestReviewHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	dst := convertPullRequestReviewHook(src)
+	switch src.EventKey {
+	case "pr:reviewer:approved", "pr:reviewer:needs_work":
+		dst.Action = scm.ActionSubmitted
+	case "pr:reviewer:unapproved":
+		dst.Action = scm.ActionDismissed
+	}
+	return dst, nil
+}
+
+func (s *webhookService) parseRawHook(event string, data []byte) (scm.Webhook, error) {
+	src := new(rawHook)
+	err := json.Unmarshal(data, src)
+	if err != nil {
+		return nil, err
+	}
+	return convertRawHook(event, src, data), nil
+}
+
 //
 // native data structures
 //
@@ -155,6 +217,40 @@ type pullRequestHook struct {
 	} `json:"previousTarget"`
 }
 
+type pullRequestCommentHook struct {
+	EventKey        string              `json:"eventKey"`
+	Date            string              `json:"date"`
+	Actor           *user               `json:"actor"`
+	PullRequest     *pr                 `json:"pullRequest"`
+	Comment         *pullRequestComment `json:"comment"`
+	CommentParentID int                 `json:"commentParentId"`
+	// only in pr:comment:edited
+	PreviousComment string `json:"previousComment"`
+}
+
+type pullRequestReviewHook struct {
+	EventKey    string `json:"eventKey"`
+	Date        string `json:"date"`
+	Actor       *user  `json:"actor"`
+	PullRequest *pr    `json:"pullRequest"`
+	Participant struct {
+		User               *user  `json:"user"`
+		LastReviewedCommit string `json:"lastReviewedCommit"`
+		Role               string `json:"role"`
+		Approved           bool   `json:"approved"`
+		Status             string `json:"status"` // "APPROVED", "UNAPPROVED", "NEEDS_WORK"
+	} `json:"participant"`
+	PreviousStatus string `json:"previousStatus"`
+}
+
+// generic payload used to extract the repository and
+// sender from events without a dedicated hook type.
+type rawHook struct {
+	Actor       *user       `json:"actor"`
+	Repository  *repository `json:"repository"`
+	PullRequest *pr         `json:"pullRequest"`
+}
+
 type change struct {
 	Ref struct {
 		ID        string `json:"id"`
@@ -267,3 +363,70 @@ func convertPullRequestHook(src *pullRequestHook) *scm.PullRequestHook {
 		Sender:      *sender,
 	}
 }
+
+func convertPullRequestCommentHook(src *pullRequestCommentHook) *scm.PullRequestCommentHook {
+	repo := convertRepository(&src.PullRequest.ToRef.Repository)
+	pr := convertPullRequest(src.PullRequest)
+	comment := convertPullRequestComment(src.Comment)
+	sender := convertUser(src.Actor)
+
+	return &scm.PullRequestCommentHook{
+		Repo:        *repo,
+		PullRequest: *pr,
+		Comment:     *comment,
+		Sender:      *sender,
+	}
+}
+
+func convertPullRequestReviewHook(src *pullRequestReviewHook) *scm.PullRequestReviewHook {
+	repo := convertRepository(&src.PullRequest.ToRef.Repository)
+	pr := convertPullRequest(src.PullRequest)
+	sender := convertUser(src.Actor)
+	reviewer := sender
+	if src.Participant.User != nil {
+		reviewer = convertUser(src.Participant.User)
+	}
+
+	return &scm.PullRequestReviewHook{
+		Repo:        *repo,
+		PullRequest: *pr,
+		Reviewer:    *reviewer,
+		State:       convertReviewState(src.Participant.Status),
+		Sender:      *sender,
+	}
+}
+
+func convertReviewState(from string) scm.ReviewState {
+	switch from {
+	case "APPROVED":
+		return scm.ReviewStateApproved
+	case "NEEDS_WORK":
+		return scm.ReviewStateChangesRequested
+	case "UNAPPROVED":
+		return scm.ReviewStatePending
+	default:
+		return scm.ReviewStateUnknown
+	}
+}
+
+func convertRawHook(event string, src *rawHook, data []byte) *scm.RawHook {
+	dst := &scm.RawHook{
+		Event: event,
+		Data:  data,
+	}
+	// the event key is in the format resource:action, eg
+	// repo:comment:added or pr:reviewer:updated
+	if i := strings.LastIndex(event, ":"); i != -1 {
+		dst.Action = event[i+1:]
+	}
+	switch {
+	case src.Repository != nil:
+		dst.Repo = *convertRepository(src.Repository)
+	case src.PullRequest != nil:
+		dst.Repo = *convertRepository(&src.PullRequest.ToRef.Repository)
+	}
+	if src.Actor != nil {
+		dst.Sender = *convertUser(src.Actor)
+	}
+	return dst
+}
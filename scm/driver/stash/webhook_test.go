@@ -147,6 +147,64 @@ func TestWebhooks(t *testing.T) {
 			after:  "testdata/webhooks/pr_deleted.json.golden",
 			obj:    new(scm.PullRequestHook),
 		},
+		// pull request comment added
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:added",
+			before: "testdata/webhooks/pr_comment_added.json",
+			after:  "testdata/webhooks/pr_comment_added.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+		// pull request comment edited
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:comment:edited",
+			before: "testdata/webhooks/pr_comment_edited.json",
+			after:  "testdata/webhooks/pr_comment_edited.json.golden",
+			obj:    new(scm.PullRequestCommentHook),
+		},
+
+		//
+		// pull request review events
+		//
+
+		// reviewer approved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:approved",
+			before: "testdata/webhooks/pr_reviewer_approved.json",
+			after:  "testdata/webhooks/pr_reviewer_approved.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
+		// reviewer needs work
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:needs_work",
+			before: "testdata/webhooks/pr_reviewer_needs_work.json",
+			after:  "testdata/webhooks/pr_reviewer_needs_work.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
+		// reviewer unapproved
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "pr:reviewer:unapproved",
+			before: "testdata/webhooks/pr_reviewer_unapproved.json",
+			after:  "testdata/webhooks/pr_reviewer_unapproved.json.golden",
+			obj:    new(scm.PullRequestReviewHook),
+		},
+
+		//
+		// mirror events
+		//
+
+		// mirror synchronized
+		{
+			sig:    "71295b197fa25f4356d2fb9965df3f2379d903d7",
+			event:  "mirror:repo_synchronized",
+			before: "testdata/webhooks/mirror_repo_synchronized.json",
+			after:  "testdata/webhooks/mirror_repo_synchronized.json.golden",
+			obj:    new(scm.PushHook),
+		},
 
 		//
 		// real-world payloads
@@ -250,6 +308,39 @@ func TestWebhookVerified(t *testing.T) {
 	}
 }
 
+func TestWebhookCommitComment(t *testing.T) {
+	f, _ := ioutil.ReadFile("testdata/webhooks/repo_comment_added.json")
+	r, _ := http.NewRequest("GET", "/", bytes.NewBuffer(f))
+	r.Header.Set("X-Event-Key", "repo:comment:added")
+
+	s := new(webhookService)
+	o, err := s.Parse(r, func(scm.Webhook) (string, error) { return "", nil })
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	hook, ok := o.(*scm.RawHook)
+	if !ok {
+		t.Errorf("Expect raw hook, got %T", o)
+		return
+	}
+	if got, want := hook.Event, "repo:comment:added"; got != want {
+		t.Errorf("Want event %q, got %q", want, got)
+	}
+	if got, want := hook.Action, "added"; got != want {
+		t.Errorf("Want action %q, got %q", want, got)
+	}
+	if got, want := hook.Repo.Name, "my-repo"; got != want {
+		t.Errorf("Want repository %q, got %q", want, got)
+	}
+	if got, want := hook.Sender.Login, "jcitizen"; got != want {
+		t.Errorf("Want sender %q, got %q", want, got)
+	}
+	if !bytes.Equal(hook.Data, f) {
+		t.Errorf("Want raw payload preserved")
+	}
+}
+
 // TestWebhooksIsoDates verifies that PR webhooks with ISO 8601 date strings
 // (Bitbucket Data Center 10.3+) produce the same output as epoch-ms payloads.
 func TestWebhooksIsoDates(t *testing.T) {
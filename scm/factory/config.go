@@ -0,0 +1,144 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Auth types.
+const (
+	AuthNone   = "none"
+	AuthToken  = "token"
+	AuthBearer = "bearer"
+	AuthBasic  = "basic"
+	AuthOAuth2 = "oauth2"
+	AuthOAuth1 = "oauth1"
+	AuthApp    = "app"
+)
+
+type (
+	// Config describes a connection to a source control
+	// management system.
+	Config struct {
+		// Driver is the driver name, for example github or
+		// gitlab. If empty, the driver is detected from the
+		// server address.
+		Driver string `json:"driver,omitempty" yaml:"driver,omitempty" env:"DRIVER"`
+
+		// Server is the api address. If empty, the default
+		// address of the driver is used.
+		Server string `json:"server,omitempty" yaml:"server,omitempty" env:"SERVER"`
+
+		// Version is the server version, used to determine
+		// the capabilities of self-hosted servers. See Probe.
+		Version string `json:"version,omitempty" yaml:"version,omitempty" env:"VERSION"`
+
+		// Account is the Harness account identifier.
+		Account string `json:"account,omitempty" yaml:"account,omitempty" env:"ACCOUNT"`
+
+		// Organization is the Azure DevOps organization, or
+		// the Harness organization identifier.
+		Organization string `json:"organization,omitempty" yaml:"organization,omitempty" env:"ORGANIZATION"`
+
+		// Project is the Azure DevOps project, or the Harness
+		// project identifier.
+		Project string `json:"project,omitempty" yaml:"project,omitempty" env:"PROJECT"`
+
+		Auth  Auth   `json:"auth,omitempty" yaml:"auth,omitempty"`
+		TLS   TLS    `json:"tls,omitempty" yaml:"tls,omitempty"`
+		Proxy string `json:"proxy,omitempty" yaml:"proxy,omitempty" env:"PROXY"`
+	}
+
+	// Auth describes the client credentials.
+	Auth struct {
+		// Type is the authentication type. If empty, the
+		// type is inferred from the credentials provided.
+		Type string `json:"type,omitempty" yaml:"type,omitempty" env:"AUTH_TYPE"`
+
+		// Token is the personal access token, oauth2 access
+		// token or oauth1 access token.
+		Token string `json:"token,omitempty" yaml:"token,omitempty" env:"TOKEN"`
+
+		Username string `json:"username,omitempty" yaml:"username,omitempty" env:"USERNAME"`
+		Password string `json:"password,omitempty" yaml:"password,omitempty" env:"PASSWORD"`
+
+		// oauth2 refresh credentials. If the TokenURL is
+		// empty, the driver token endpoint is used.
+		ClientID     string `json:"client_id,omitempty" yaml:"client_id,omitempty" env:"CLIENT_ID"`
+		ClientSecret string `json:"client_secret,omitempty" yaml:"client_secret,omitempty" env:"CLIENT_SECRET"`
+		RefreshToken string `json:"refresh_token,omitempty" yaml:"refresh_token,omitempty" env:"REFRESH_TOKEN"`
+		TokenURL     string `json:"token_url,omitempty" yaml:"token_url,omitempty" env:"TOKEN_URL"`
+
+		// ConsumerKey is the oauth1 consumer key.
+		ConsumerKey string `json:"consumer_key,omitempty" yaml:"consumer_key,omitempty" env:"CONSUMER_KEY"`
+
+		// PrivateKey is the pem encoded oauth1 consumer or
+		// GitHub App private key. PrivateKeyFile is the path
+		// to the private key file.
+		PrivateKey     string `json:"private_key,omitempty" yaml:"private_key,omitempty" env:"PRIVATE_KEY"`
+		PrivateKeyFile string `json:"private_key_file,omitempty" yaml:"private_key_file,omitempty" env:"PRIVATE_KEY_FILE"`
+
+		// GitHub App credentials.
+		AppID          string `json:"app_id,omitempty" yaml:"app_id,omitempty" env:"APP_ID"`
+		InstallationID int64  `json:"installation_id,omitempty" yaml:"installation_id,omitempty" env:"INSTALLATION_ID"`
+	}
+
+	// TLS describes the client tls configuration.
+	TLS struct {
+		// CAFile is the path to a pem encoded certificate
+		// authority bundle. CACert is the bundle contents.
+		CAFile string `json:"ca_file,omitempty" yaml:"ca_file,omitempty" env:"CA_FILE"`
+		CACert string `json:"ca_cert,omitempty" yaml:"ca_cert,omitempty" env:"CA_CERT"`
+
+		// InsecureSkipVerify disables verification of the
+		// server certificate.
+		InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty" yaml:"insecure_skip_verify,omitempty" env:"SKIP_VERIFY"`
+	}
+)
+
+// Parse parses the json encoded configuration. Yaml
+// configuration files are parsed by the separate
+// github.com/drone/go-scm/scm/factory/yaml module, so
+// that the core module does not depend on a yaml parser.
+func Parse(data []byte) (*Config, error) {
+	config := new(Config)
+	err := json.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Load reads and parses the json configuration file.
+func Load(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// authType returns the authentication type, inferred from
+// the credentials if not explicitly configured.
+func (a *Auth) authType() string {
+	switch {
+	case a.Type != "":
+		return a.Type
+	case a.AppID != "":
+		return AuthApp
+	case a.ConsumerKey != "":
+		return AuthOAuth1
+	case a.RefreshToken != "":
+		return AuthOAuth2
+	case a.Username != "":
+		return AuthBasic
+	case a.Token != "":
+		return AuthToken
+	default:
+		return AuthNone
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+)
+
+// FromEnv returns the configuration from the environment.
+// Each variable name is the prefix followed by the name in
+// the env struct tag, for example SCM_SERVER and SCM_TOKEN
+// with the SCM_ prefix.
+func FromEnv(prefix string) (*Config, error) {
+	config := new(Config)
+	err := loadEnv(reflect.ValueOf(config).Elem(), prefix)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// loadEnv sets the struct fields from the environment.
+func loadEnv(v reflect.Value, prefix string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.Struct {
+			if err := loadEnv(field, prefix); err != nil {
+				return err
+			}
+			continue
+		}
+		name := t.Field(i).Tag.Get("env")
+		if name == "" {
+			continue
+		}
+		value, ok := os.LookupEnv(prefix + name)
+		if !ok || value == "" {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(value)
+		case reflect.Bool:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s%s: %s", prefix, name, err)
+			}
+			field.SetBool(b)
+		case reflect.Int64:
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s%s: %s", prefix, name, err)
+			}
+			field.SetInt(n)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package factory creates an authenticated scm.Client from
+// a declarative connection configuration, which can be
+// loaded from a json file, the environment or a url. Yaml
+// files are loaded by the github.com/drone/go-scm/scm/factory/yaml
+// module.
+package factory
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/azure"
+	"github.com/drone/go-scm/scm/driver/bitbucket"
+	"github.com/drone/go-scm/scm/driver/gitea"
+	"github.com/drone/go-scm/scm/driver/gitee"
+	"github.com/drone/go-scm/scm/driver/github"
+	"github.com/drone/go-scm/scm/driver/gitlab"
+	"github.com/drone/go-scm/scm/driver/gogs"
+	"github.com/drone/go-scm/scm/driver/harness"
+	"github.com/drone/go-scm/scm/driver/stash"
+	"github.com/drone/go-scm/scm/login"
+	"github.com/drone/go-scm/scm/transport"
+	"github.com/drone/go-scm/scm/transport/githubapp"
+	"github.com/drone/go-scm/scm/transport/oauth1"
+	"github.com/drone/go-scm/scm/transport/oauth2"
+)
+
+// defaultServers provides the api address of drivers with
+// a public hosted service.
+var defaultServers = map[string]string{
+	"azure":     "https://dev.azure.com",
+	"bitbucket": "https://api.bitbucket.org",
+	"gitee":     "https://gitee.com/api/v5",
+	"github":    "https://api.github.com",
+	"gitlab":    "https://gitlab.com",
+}
+
+// New returns a new client for the configuration.
+func New(config *Config) (*scm.Client, error) {
+	config, err := normalize(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newDriver(config)
+	if err != nil {
+		return nil, err
+	}
+	client.Version = config.Version
+	base, err := baseTransport(config)
+	if err != nil {
+		return nil, err
+	}
+	rt, err := authTransport(config, base)
+	if err != nil {
+		return nil, err
+	}
+	if rt != nil {
+		client.Client = &http.Client{Transport: rt}
+	}
+	return client, nil
+}
+
+// FromURL returns a new client for the repository, web or
+// api address. See ParseURL.
+func FromURL(rawurl string) (*scm.Client, error) {
+	config, err := ParseURL(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return New(config)
+}
+
+// normalize returns a copy of the configuration with the
+// driver detected and the default server address applied.
+func normalize(in *Config) (*Config, error) {
+	config := *in
+	config.Driver = strings.ToLower(config.Driver)
+	if config.Driver == "" {
+		if config.Server == "" {
+			return nil, errors.New("scm driver or server address is required")
+		}
+		uri, err := url.Parse(config.Server)
+		if err != nil {
+			return nil, err
+		}
+		detected, err := detect(uri)
+		if err != nil {
+			return nil, err
+		}
+		config.Driver = detected.Driver
+		config.Server = detected.Server
+		if config.Organization == "" {
+			config.Organization = detected.Organization
+		}
+		if config.Project == "" {
+			config.Project = detected.Project
+		}
+	}
+	if config.Server == "" {
+		config.Server = defaultServers[config.Driver]
+	}
+	if config.Server == "" {
+		return nil, fmt.Errorf("%s server address is required", config.Driver)
+	}
+	return &config, nil
+}
+
+// newDriver returns a new unauthenticated client.
+func newDriver(config *Config) (*scm.Client, error) {
+	switch config.Driver {
+	case "azure":
+		return azure.New(config.Server, config.Organization, config.Project)
+	case "bitbucket":
+		return bitbucket.New(config.Server)
+	case "gitea":
+		return gitea.New(config.Server)
+	case "gitee":
+		return gitee.New(config.Server)
+	case "github":
+		return github.New(config.Server)
+	case "gitlab":
+		return gitlab.New(config.Server)
+	case "gogs":
+		return gogs.New(config.Server)
+	case "harness":
+		return harness.New(config.Server, config.Account, config.Organization, config.Project)
+	case "stash":
+		return stash.New(config.Server)
+	default:
+		return nil, fmt.Errorf("unsupported scm driver: %s", config.Driver)
+	}
+}
+
+// baseTransport returns the http transport configured with
+// the tls and proxy settings, or nil if the default
+// transport should be used.
+func baseTransport(config *Config) (http.RoundTripper, error) {
+	if config.Proxy == "" && config.TLS == (TLS{}) {
+		return nil, nil
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if config.Proxy != "" {
+		proxy, err := url.Parse(config.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		t.Proxy = http.ProxyURL(proxy)
+	}
+	if config.TLS != (TLS{}) {
+		t.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: config.TLS.InsecureSkipVerify,
+		}
+		pem := []byte(config.TLS.CACert)
+		if config.TLS.CAFile != "" {
+			data, err := ioutil.ReadFile(config.TLS.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			pem = data
+		}
+		if len(pem) != 0 {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, errors.New("no certificates found in the ca bundle")
+			}
+			t.TLSClientConfig.RootCAs = pool
+		}
+	}
+	return t, nil
+}
+
+// authTransport returns the transport that authenticates
+// requests, or nil if no credentials are configured and
+// the default transport should be used.
+func authTransport(config *Config, base http.RoundTripper) (http.RoundTripper, error) {
+	auth := config.Auth
+	switch auth.authType() {
+	case AuthNone:
+		return base, nil
+	case AuthToken:
+		return tokenTransport(config.Driver, auth.Token, base), nil
+	case AuthBearer:
+		return &transport.BearerToken{Base: base, Token: auth.Token}, nil
+	case AuthBasic:
+		return &transport.BasicAuth{
+			Base:     base,
+			Username: auth.Username,
+			Password: auth.Password,
+		}, nil
+	case AuthOAuth2:
+		endpoint := auth.TokenURL
+		if endpoint == "" {
+			endpoint = tokenURL(config)
+		}
+		if endpoint == "" {
+			return nil, fmt.Errorf("%s oauth2 token url is required", config.Driver)
+		}
+		return &oauth2.Transport{
+			Base: base,
+			Source: &oauth2.CachingRefresher{
+				ClientID:     auth.ClientID,
+				ClientSecret: auth.ClientSecret,
+				Endpoint:     endpoint,
+				Client:       &http.Client{Transport: base},
+				Source: oauth2.StaticTokenSource(&scm.Token{
+					Token:   auth.Token,
+					Refresh: auth.RefreshToken,
+				}),
+			},
+		}, nil
+	case AuthOAuth1:
+		if config.Driver != "stash" {
+			return nil, fmt.Errorf("oauth1 is not supported by the %s driver", config.Driver)
+		}
+		key, err := privateKey(auth)
+		if err != nil {
+			return nil, err
+		}
+		return &oauth1.Transport{
+			Base:        base,
+			ConsumerKey: auth.ConsumerKey,
+			PrivateKey:  key,
+			Source:      oauth1.StaticTokenSource(&scm.Token{Token: auth.Token}),
+		}, nil
+	case AuthApp:
+		if config.Driver != "github" {
+			return nil, fmt.Errorf("app authentication is not supported by the %s driver", config.Driver)
+		}
+		if auth.InstallationID == 0 {
+			return nil, errors.New("github app installation id is required")
+		}
+		key, err := privateKey(auth)
+		if err != nil {
+			return nil, err
+		}
+		app := &githubapp.App{
+			ID:         auth.AppID,
+			PrivateKey: key,
+			Server:     config.Server,
+			Client:     &http.Client{Transport: base},
+		}
+		return &oauth2.Transport{
+			Base:   base,
+			Source: app.Installation(auth.InstallationID),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth type: %s", auth.Type)
+	}
+}
+
+// tokenTransport returns the transport that authenticates
+// requests with a personal access token, using the header
+// expected by the driver.
+func tokenTransport(driver, token string, base http.RoundTripper) http.RoundTripper {
+	switch driver {
+	case "azure":
+		return &transport.BasicAuth{Base: base, Password: token}
+	case "gitlab":
+		return &transport.PrivateToken{Base: base, Token: token}
+	case "gitea", "gogs":
+		return &transport.Authorization{Base: base, Scheme: oauth2.SchemeToken, Credentials: token}
+	case "harness":
+		return &transport.Custom{
+			Base: base,
+			Before: func(r *http.Request) {
+				r.Header.Set("x-api-key", token)
+			},
+		}
+	default:
+		return &transport.BearerToken{Base: base, Token: token}
+	}
+}
+
+// tokenURL returns the oauth2 token endpoint of the driver.
+func tokenURL(config *Config) string {
+	switch config.Driver {
+	case "azure":
+		return login.Azure("").TokenURL
+	case "bitbucket":
+		return login.Bitbucket().TokenURL
+	case "gitea":
+		return login.Gitea(config.Server).TokenURL
+	case "gitee":
+		return login.Gitee().TokenURL
+	case "github":
+		server := strings.TrimSuffix(strings.TrimSuffix(config.Server, "/"), "/api/v3")
+		if server == "https://api.github.com" {
+			server = ""
+		}
+		return login.GitHub(server).TokenURL
+	case "gitlab":
+		return login.GitLab(config.Server).TokenURL
+	default:
+		return ""
+	}
+}
+
+// privateKey returns the parsed private key.
+func privateKey(auth Auth) (*rsa.PrivateKey, error) {
+	data := []byte(auth.PrivateKey)
+	if auth.PrivateKeyFile != "" {
+		var err error
+		data, err = ioutil.ReadFile(auth.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return githubapp.ParsePrivateKey(data)
+}
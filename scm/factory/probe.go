@@ -0,0 +1,216 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ServerInfo describes the server detected by Probe.
+type ServerInfo struct {
+	// Driver is the driver name.
+	Driver string
+
+	// Server is the api address.
+	Server string
+
+	// Version is the server version, or empty if the server
+	// is a hosted service or the version is not disclosed.
+	Version string
+}
+
+// Probe identifies the provider and version of the server
+// at the base url, using the version and metadata endpoints
+// and the response headers. The hosted services are
+// identified by host name without making any requests.
+//
+// If the client is nil, the default client is used. GitLab
+// requires authentication to disclose the version, and is
+// otherwise identified without a version.
+func Probe(ctx context.Context, client *http.Client, rawurl string) (*ServerInfo, error) {
+	uri, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if config := detectHost(uri); config != nil {
+		return &ServerInfo{Driver: config.Driver, Server: config.Server}, nil
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base := baseAddress(uri)
+	for _, probe := range probes {
+		info, err := probe(ctx, client, base)
+		if err != nil {
+			return nil, err
+		}
+		if info != nil {
+			return info, nil
+		}
+	}
+
+	// fallback to detecting the driver by host name.
+	config, err := detect(uri)
+	if err != nil {
+		return nil, err
+	}
+	return &ServerInfo{Driver: config.Driver, Server: config.Server}, nil
+}
+
+// probe identifies the server, returning nil if the server
+// does not match.
+type probe func(ctx context.Context, client *http.Client, base string) (*ServerInfo, error)
+
+// probes is the ordered list of probes. The probes that
+// use unauthenticated version endpoints are tried first.
+var probes = []probe{
+	probeGitea,
+	probeStash,
+	probeGithub,
+	probeGitlab,
+	probeGogs,
+}
+
+// probeGitea identifies Gitea, and Gitea forks, using the
+// version endpoint.
+func probeGitea(ctx context.Context, client *http.Client, base string) (*ServerInfo, error) {
+	res, err := get(ctx, client, base+"/api/v1/version")
+	if err != nil || res == nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	out := struct {
+		Version string `json:"version"`
+	}{}
+	if res.StatusCode != 200 || decode(res.Body, &out) != nil || out.Version == "" {
+		return nil, nil
+	}
+	return &ServerInfo{Driver: "gitea", Server: base, Version: out.Version}, nil
+}
+
+// probeStash identifies Bitbucket Server and Data Center
+// using the application properties endpoint.
+func probeStash(ctx context.Context, client *http.Client, base string) (*ServerInfo, error) {
+	res, err := get(ctx, client, base+"/rest/api/1.0/application-properties")
+	if err != nil || res == nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	out := struct {
+		Version string `json:"version"`
+	}{}
+	if res.StatusCode != 200 || decode(res.Body, &out) != nil || out.Version == "" {
+		return nil, nil
+	}
+	return &ServerInfo{Driver: "stash", Server: base, Version: out.Version}, nil
+}
+
+// probeGithub identifies GitHub Enterprise Server using
+// the meta endpoint.
+func probeGithub(ctx context.Context, client *http.Client, base string) (*ServerInfo, error) {
+	res, err := get(ctx, client, base+"/api/v3/meta")
+	if err != nil || res == nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	version := res.Header.Get("X-GitHub-Enterprise-Version")
+	if version == "" {
+		out := struct {
+			Version string `json:"installed_version"`
+		}{}
+		if res.StatusCode == 200 && decode(res.Body, &out) == nil {
+			version = out.Version
+		}
+	}
+	if version == "" && res.Header.Get("X-GitHub-Request-Id") == "" {
+		return nil, nil
+	}
+	return &ServerInfo{Driver: "github", Server: base + "/api/v3", Version: version}, nil
+}
+
+// probeGitlab identifies GitLab using the version endpoint.
+// The endpoint requires authentication, however the
+// unauthorized response is identified by the meta header.
+func probeGitlab(ctx context.Context, client *http.Client, base string) (*ServerInfo, error) {
+	res, err := get(ctx, client, base+"/api/v4/version")
+	if err != nil || res == nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	out := struct {
+		Version string `json:"version"`
+	}{}
+	if res.StatusCode == 200 && decode(res.Body, &out) == nil && out.Version != "" {
+		return &ServerInfo{Driver: "gitlab", Server: base, Version: out.Version}, nil
+	}
+	if res.Header.Get("X-Gitlab-Meta") != "" {
+		return &ServerInfo{Driver: "gitlab", Server: base}, nil
+	}
+	return nil, nil
+}
+
+// probeGogs identifies Gogs using the session cookie set
+// by the home page. Gogs does not disclose the version.
+func probeGogs(ctx context.Context, client *http.Client, base string) (*ServerInfo, error) {
+	res, err := get(ctx, client, base+"/")
+	if err != nil || res == nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	for _, cookie := range res.Cookies() {
+		switch cookie.Name {
+		case "i_like_gogs":
+			return &ServerInfo{Driver: "gogs", Server: base}, nil
+		case "i_like_gitea":
+			return &ServerInfo{Driver: "gitea", Server: base}, nil
+		}
+	}
+	return nil, nil
+}
+
+// get sends a get request to the url. Network errors are
+// returned as a nil response, so that the next probe is
+// tried, unless the context is cancelled.
+func get(ctx context.Context, client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, ctx.Err()
+	}
+	return res, nil
+}
+
+// decode decodes the json response body, limited to 1MB.
+func decode(r io.Reader, v interface{}) error {
+	data, err := ioutil.ReadAll(io.LimitReader(r, 1<<20))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// baseAddress returns the server address without the api
+// path or trailing slash.
+func baseAddress(uri *url.URL) string {
+	path := uri.Path
+	for _, prefix := range []string{"/rest/api/", "/api/"} {
+		if i := strings.Index(path, prefix); i != -1 {
+			path = path[:i]
+		}
+	}
+	return uri.Scheme + "://" + uri.Host + strings.TrimSuffix(path, "/")
+}
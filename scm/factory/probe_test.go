@@ -0,0 +1,130 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProbe(t *testing.T) {
+	tests := []struct {
+		driver  string
+		version string
+		path    string
+		handler http.HandlerFunc
+	}{
+		{
+			driver:  "gitea",
+			version: "1.21.4",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v1/version" {
+					fmt.Fprint(w, `{"version":"1.21.4"}`)
+					return
+				}
+				w.WriteHeader(404)
+			},
+		},
+		{
+			driver:  "stash",
+			version: "8.9.2",
+			path:    "/rest/api/1.0/projects",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/rest/api/1.0/application-properties" {
+					fmt.Fprint(w, `{"version":"8.9.2","buildNumber":"8009002","buildDate":"1686218571412","displayName":"Bitbucket"}`)
+					return
+				}
+				w.WriteHeader(404)
+			},
+		},
+		{
+			driver:  "github",
+			version: "3.11.0",
+			path:    "/api/v3",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v3/meta" {
+					w.Header().Set("X-GitHub-Enterprise-Version", "3.11.0")
+					fmt.Fprint(w, `{"verifiable_password_authentication":true,"installed_version":"3.11.0"}`)
+					return
+				}
+				w.WriteHeader(404)
+			},
+		},
+		{
+			driver:  "gitlab",
+			version: "16.8.1-ee",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v4/version" {
+					fmt.Fprint(w, `{"version":"16.8.1-ee","revision":"a3c4b6e2a3e"}`)
+					return
+				}
+				w.WriteHeader(404)
+			},
+		},
+		{
+			driver: "gitlab",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v4/version" {
+					w.Header().Set("X-Gitlab-Meta", `{"correlation_id":"01HN","version":"1"}`)
+					w.WriteHeader(401)
+					fmt.Fprint(w, `{"message":"401 Unauthorized"}`)
+					return
+				}
+				w.WriteHeader(404)
+			},
+		},
+		{
+			driver: "gogs",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/" {
+					http.SetCookie(w, &http.Cookie{Name: "i_like_gogs", Value: "e0c6a0d4b7b9b3e1"})
+					return
+				}
+				w.WriteHeader(404)
+			},
+		},
+	}
+	for _, test := range tests {
+		server := httptest.NewServer(test.handler)
+		info, err := Probe(context.Background(), nil, server.URL+test.path)
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: %s", test.driver, err)
+			continue
+		}
+		if got, want := info.Driver, test.driver; got != want {
+			t.Errorf("Want driver %s, got %s", want, got)
+		}
+		if got, want := info.Version, test.version; got != want {
+			t.Errorf("Want %s version %q, got %q", test.driver, want, got)
+		}
+	}
+}
+
+func TestProbe_Hosted(t *testing.T) {
+	info, err := Probe(context.Background(), nil, "https://gitlab.com/gitlab-org/gitlab")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := info.Driver, "gitlab"; got != want {
+		t.Errorf("Want driver %s, got %s", want, got)
+	}
+	if got, want := info.Server, "https://gitlab.com"; got != want {
+		t.Errorf("Want server %s, got %s", want, got)
+	}
+}
+
+func TestProbe_Unknown(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	if _, err := Probe(context.Background(), nil, server.URL); err != ErrUnknownDriver {
+		t.Errorf("Want unknown driver error, got %v", err)
+	}
+}
@@ -0,0 +1,11 @@
+module github.com/drone/go-scm/scm/factory/yaml
+
+go 1.13
+
+require (
+	github.com/drone/go-scm v1.0.0
+	github.com/google/go-cmp v0.2.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+replace github.com/drone/go-scm => ../../..
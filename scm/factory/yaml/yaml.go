@@ -0,0 +1,39 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package yaml loads the factory connection configuration
+// from a yaml file.
+//
+// This package is a separate module so that the core
+// module does not depend on a yaml parser.
+package yaml
+
+import (
+	"io/ioutil"
+
+	"github.com/drone/go-scm/scm/factory"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// Parse parses the yaml encoded configuration. Since yaml
+// is a superset of json, json encoded configuration is
+// also accepted.
+func Parse(data []byte) (*factory.Config, error) {
+	config := new(factory.Config)
+	err := yaml.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Load reads and parses the yaml configuration file.
+func Load(path string) (*factory.Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
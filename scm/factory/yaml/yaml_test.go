@@ -0,0 +1,56 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package yaml
+
+import (
+	"testing"
+
+	"github.com/drone/go-scm/scm/factory"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	want := &factory.Config{
+		Driver: "gitlab",
+		Server: "https://gitlab.example.com",
+		Auth: factory.Auth{
+			Type:  "token",
+			Token: "3da541559918a808c2402bba5012f6c60b27661c",
+		},
+		TLS: factory.TLS{
+			CAFile: "/etc/ssl/certs/ca.pem",
+		},
+		Proxy: "http://proxy.example.com:3128",
+	}
+
+	yaml := `
+driver: gitlab
+server: https://gitlab.example.com
+auth:
+  type: token
+  token: 3da541559918a808c2402bba5012f6c60b27661c
+tls:
+  ca_file: /etc/ssl/certs/ca.pem
+proxy: http://proxy.example.com:3128
+`
+	json := `{
+  "driver": "gitlab",
+  "server": "https://gitlab.example.com",
+  "auth": {"type": "token", "token": "3da541559918a808c2402bba5012f6c60b27661c"},
+  "tls": {"ca_file": "/etc/ssl/certs/ca.pem"},
+  "proxy": "http://proxy.example.com:3128"
+}`
+	for _, data := range []string{yaml, json} {
+		got, err := Parse([]byte(data))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("Unexpected Results")
+			t.Log(diff)
+		}
+	}
+}
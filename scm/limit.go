@@ -0,0 +1,193 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate and concurrency of api requests.
+type Limiter interface {
+	// Wait blocks until the request may be sent. It returns
+	// a function that must be invoked once the request is
+	// complete, with the response, or nil if the request
+	// failed.
+	Wait(ctx context.Context, req *http.Request) (done func(*Response), err error)
+}
+
+// HostLimiter is a Limiter that limits the rate and the
+// number of in-flight requests for each host.
+//
+// The limiter adapts to the rate limit reported by the
+// server. Requests are paused until the rate limit resets
+// once it is exhausted, or until the Retry-After duration
+// elapses when a secondary rate limit is exceeded, and are
+// spread evenly over the remaining window once fewer than
+// ten percent of the requests remain.
+type HostLimiter struct {
+	// RequestsPerSecond is the maximum number of requests
+	// per second to each host. Zero means no limit.
+	RequestsPerSecond float64
+
+	// Burst is the number of requests that may be sent at
+	// once before the rate limit applies. Defaults to 1.
+	Burst int
+
+	// MaxInFlight is the maximum number of concurrent
+	// requests to each host. Zero means no limit.
+	MaxInFlight int
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimit
+}
+
+// hostLimit tracks the state of the limiter for a host.
+type hostLimit struct {
+	sem chan struct{}
+
+	// tat is the theoretical arrival time of the next
+	// request when requests are sent at the limited rate.
+	tat time.Time
+
+	// interval is the minimum interval between requests
+	// derived from the remaining server rate limit.
+	interval time.Duration
+
+	// paused is the time until which requests are paused.
+	paused time.Time
+}
+
+// Wait blocks until the request may be sent.
+func (l *HostLimiter) Wait(ctx context.Context, req *http.Request) (func(*Response), error) {
+	h := l.host(req.URL.Host)
+	if h.sem != nil {
+		select {
+		case h.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if h.sem != nil {
+			<-h.sem
+		}
+	}
+	if err := sleep(ctx, l.reserve(h)); err != nil {
+		release()
+		return nil, err
+	}
+	return func(res *Response) {
+		if res != nil {
+			l.update(h, res)
+		}
+		release()
+	}, nil
+}
+
+// host returns the limiter state for the named host.
+func (l *HostLimiter) host(name string) *hostLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.hosts == nil {
+		l.hosts = map[string]*hostLimit{}
+	}
+	h, ok := l.hosts[name]
+	if !ok {
+		h = new(hostLimit)
+		if l.MaxInFlight > 0 {
+			h.sem = make(chan struct{}, l.MaxInFlight)
+		}
+		l.hosts[name] = h
+	}
+	return h
+}
+
+// reserve reserves the next request slot for the host and
+// returns the duration to wait until the request is sent.
+func (l *HostLimiter) reserve(h *hostLimit) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+
+	var interval time.Duration
+	if l.RequestsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / l.RequestsPerSecond)
+	}
+	if h.interval > interval {
+		interval = h.interval
+	}
+	burst := l.Burst
+	if burst < 1 {
+		burst = 1
+	}
+
+	tat := h.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	at := tat.Add(-interval * time.Duration(burst-1))
+	if at.Before(now) {
+		at = now
+	}
+	if at.Before(h.paused) {
+		at = h.paused
+	}
+	if tat.Before(at) {
+		tat = at
+	}
+	h.tat = tat.Add(interval)
+	return at.Sub(now)
+}
+
+// update adapts the limiter to the rate limit reported by
+// the server.
+func (l *HostLimiter) update(h *hostLimit, res *Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+
+	if res.Status == 429 || res.Status == 403 {
+		if secs, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && secs > 0 {
+			h.paused = now.Add(time.Duration(secs) * time.Second)
+			return
+		}
+	}
+
+	rate := res.Rate
+	if rate.Limit == 0 || rate.Reset == 0 {
+		return
+	}
+	reset := time.Unix(rate.Reset, 0)
+	switch {
+	case !reset.After(now):
+		h.interval = 0
+	case rate.Remaining == 0:
+		h.paused = reset
+	case rate.Remaining < rate.Limit/10:
+		h.interval = reset.Sub(now) / time.Duration(rate.Remaining)
+	default:
+		h.interval = 0
+	}
+}
+
+// sleep pauses for the duration, or until the context is
+// cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHostLimiter_Rate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	rec := new(recorder)
+	client := &Client{
+		Limiter:  &HostLimiter{RequestsPerSecond: 50, Burst: 2},
+		Observer: rec,
+	}
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		res, err := client.Do(context.Background(), &Request{Method: "GET", Path: "user"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+	// the first two requests are sent immediately, and the
+	// remaining requests are spaced 20ms apart.
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("Want requests rate limited, completed in %s", elapsed)
+	}
+	if rec.before[0].Wait > 10*time.Millisecond {
+		t.Errorf("Want first request sent immediately, waited %s", rec.before[0].Wait)
+	}
+	if rec.before[4].Wait == 0 {
+		t.Errorf("Want wait time observed")
+	}
+}
+
+func TestHostLimiter_MaxInFlight(t *testing.T) {
+	var inflight, peak int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inflight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inflight, -1)
+	}))
+	defer server.Close()
+
+	client := &Client{Limiter: &HostLimiter{MaxInFlight: 2}}
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.Do(context.Background(), &Request{Method: "GET", Path: "user"})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			res.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&peak); got > 2 {
+		t.Errorf("Want at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestHostLimiter_Cancel(t *testing.T) {
+	l := &HostLimiter{MaxInFlight: 1}
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+
+	done, err := l.Wait(context.Background(), req)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer done(nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Wait(ctx, req); err != context.DeadlineExceeded {
+		t.Errorf("Want deadline exceeded, got %v", err)
+	}
+}
+
+func TestHostLimiter_Exhausted(t *testing.T) {
+	l := &HostLimiter{}
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+
+	done, _ := l.Wait(context.Background(), req)
+	done(&Response{
+		Status: 403,
+		Header: http.Header{},
+		Rate: Rate{
+			Limit:     5000,
+			Remaining: 0,
+			Reset:     time.Now().Add(time.Hour).Unix(),
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Wait(ctx, req); err != context.DeadlineExceeded {
+		t.Errorf("Want requests paused until the rate limit resets, got %v", err)
+	}
+
+	// requests to other hosts are not paused.
+	other, _ := http.NewRequest("GET", "https://gitlab.com/api/v4/user", nil)
+	if _, err := l.Wait(context.Background(), other); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHostLimiter_RetryAfter(t *testing.T) {
+	l := &HostLimiter{}
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+
+	done, _ := l.Wait(context.Background(), req)
+	done(&Response{
+		Status: 429,
+		Header: http.Header{"Retry-After": {strconv.Itoa(60)}},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.Wait(ctx, req); err != context.DeadlineExceeded {
+		t.Errorf("Want requests paused until retry after, got %v", err)
+	}
+}
+
+func TestHostLimiter_Adaptive(t *testing.T) {
+	l := &HostLimiter{}
+	req, _ := http.NewRequest("GET", "https://api.github.com/user", nil)
+
+	done, _ := l.Wait(context.Background(), req)
+	done(&Response{
+		Status: 200,
+		Header: http.Header{},
+		Rate: Rate{
+			Limit:     5000,
+			Remaining: 100,
+			Reset:     time.Now().Add(100 * time.Second).Unix(),
+		},
+	})
+
+	// with 100 requests remaining over 100 seconds, the
+	// requests are spaced approximately one second apart.
+	l.reserve(l.host("api.github.com"))
+	if wait := l.reserve(l.host("api.github.com")); wait < 500*time.Millisecond {
+		t.Errorf("Want requests spread over the rate limit window, got wait %s", wait)
+	}
+}
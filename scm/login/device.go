@@ -0,0 +1,124 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package login
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// ErrDeviceNotSupported is returned when the provider does
+// not support the device authorization grant.
+var ErrDeviceNotSupported = errors.New("login: device authorization grant not supported")
+
+// slowDown is the amount of time added to the polling
+// interval when the provider asks the client to slow down.
+var slowDown = 5 * time.Second
+
+// DeviceCode represents the device authorization response,
+// which contains the code the user enters at the verification
+// url to authorize the device.
+type DeviceCode struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	Expires                 time.Time
+	Interval                time.Duration
+}
+
+// DeviceAuth starts the device authorization grant and
+// returns the device and user verification codes.
+func (c *Config) DeviceAuth(ctx context.Context) (*DeviceCode, error) {
+	if c.Endpoint.DeviceAuthURL == "" {
+		return nil, ErrDeviceNotSupported
+	}
+	params := url.Values{}
+	if len(c.Scopes) != 0 {
+		params.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	out := new(deviceCode)
+	err := c.post(ctx, c.Endpoint.DeviceAuthURL, params, out)
+	if err != nil {
+		return nil, err
+	}
+	code := &DeviceCode{
+		DeviceCode:              out.DeviceCode,
+		UserCode:                out.UserCode,
+		VerificationURI:         out.VerificationURI,
+		VerificationURIComplete: out.VerificationURIComplete,
+		Interval:                time.Duration(out.Interval) * time.Second,
+	}
+	// microsoft uses the non-standard verification_url field.
+	if code.VerificationURI == "" {
+		code.VerificationURI = out.VerificationURL
+	}
+	if out.ExpiresIn != 0 {
+		code.Expires = time.Now().Add(
+			time.Duration(out.ExpiresIn) * time.Second,
+		)
+	}
+	if code.Interval == 0 {
+		code.Interval = 5 * time.Second
+	}
+	return code, nil
+}
+
+// DeviceToken polls the token endpoint until the user
+// authorizes the device, the user denies the request, the
+// device code expires, or the context is canceled.
+func (c *Config) DeviceToken(ctx context.Context, code *DeviceCode) (*scm.Token, error) {
+	interval := code.Interval
+	for {
+		if !code.Expires.IsZero() && time.Now().After(code.Expires) {
+			return nil, &Error{Code: "expired_token"}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		params := url.Values{}
+		params.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+		params.Set("device_code", code.DeviceCode)
+		token, err := c.token(ctx, params)
+		if err == nil {
+			return token, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		e, ok := err.(*Error)
+		if !ok {
+			return nil, err
+		}
+		switch e.Code {
+		case "authorization_pending":
+			// the user has not yet authorized the device.
+		case "slow_down":
+			interval += slowDown
+		default:
+			return nil, err
+		}
+	}
+}
+
+// deviceCode is the device authorization response.
+type deviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURL         string `json:"verification_url"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package login
+
+import "strings"
+
+// AuthStyle represents how the client credentials are sent
+// to the token endpoint.
+type AuthStyle int
+
+// AuthStyle values.
+const (
+	// AuthStyleInParams sends the client id and secret as
+	// form parameters in the request body.
+	AuthStyleInParams AuthStyle = iota
+
+	// AuthStyleInHeader sends the client id and secret using
+	// http basic authorization.
+	AuthStyleInHeader
+)
+
+// Endpoint represents the oauth2 endpoints of a provider.
+type Endpoint struct {
+	AuthURL       string
+	TokenURL      string
+	DeviceAuthURL string
+	AuthStyle     AuthStyle
+}
+
+// GitHub returns the GitHub endpoints. If the server address
+// is empty, the GitHub.com endpoints are returned.
+func GitHub(server string) Endpoint {
+	server = serverOrDefault(server, "https://github.com")
+	return Endpoint{
+		AuthURL:       server + "/login/oauth/authorize",
+		TokenURL:      server + "/login/oauth/access_token",
+		DeviceAuthURL: server + "/login/device/code",
+	}
+}
+
+// GitLab returns the GitLab endpoints. If the server address
+// is empty, the GitLab.com endpoints are returned.
+func GitLab(server string) Endpoint {
+	server = serverOrDefault(server, "https://gitlab.com")
+	return Endpoint{
+		AuthURL:       server + "/oauth/authorize",
+		TokenURL:      server + "/oauth/token",
+		DeviceAuthURL: server + "/oauth/authorize_device",
+	}
+}
+
+// Gitea returns the Gitea endpoints. Gitea does not support
+// the device authorization grant.
+func Gitea(server string) Endpoint {
+	server = serverOrDefault(server, "https://gitea.com")
+	return Endpoint{
+		AuthURL:  server + "/login/oauth/authorize",
+		TokenURL: server + "/login/oauth/access_token",
+	}
+}
+
+// Gitee returns the Gitee endpoints. Gitee does not support
+// the device authorization grant.
+func Gitee() Endpoint {
+	return Endpoint{
+		AuthURL:  "https://gitee.com/oauth/authorize",
+		TokenURL: "https://gitee.com/oauth/token",
+	}
+}
+
+// Bitbucket returns the Bitbucket Cloud endpoints. Bitbucket
+// Cloud does not support the device authorization grant.
+func Bitbucket() Endpoint {
+	return Endpoint{
+		AuthURL:   "https://bitbucket.org/site/oauth2/authorize",
+		TokenURL:  "https://bitbucket.org/site/oauth2/access_token",
+		AuthStyle: AuthStyleInHeader,
+	}
+}
+
+// Azure returns the Microsoft Entra ID endpoints used to
+// authorize Azure DevOps. If the tenant is empty, the
+// organizations tenant is used. Azure DevOps requires the
+// 499b84ac-1321-427f-aa17-267ca6975798/.default scope.
+func Azure(tenant string) Endpoint {
+	if tenant == "" {
+		tenant = "organizations"
+	}
+	server := "https://login.microsoftonline.com/" + tenant + "/oauth2/v2.0"
+	return Endpoint{
+		AuthURL:       server + "/authorize",
+		TokenURL:      server + "/token",
+		DeviceAuthURL: server + "/devicecode",
+	}
+}
+
+// serverOrDefault returns the server address without a
+// trailing slash, or the default address if empty.
+func serverOrDefault(server, def string) string {
+	if server == "" {
+		return def
+	}
+	return strings.TrimSuffix(server, "/")
+}
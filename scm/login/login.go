@@ -0,0 +1,208 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package login implements the oauth2 authorization code
+// grant, with PKCE, and the device authorization grant used
+// to obtain access tokens from the supported providers.
+package login
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// Config describes the oauth2 client application.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	Endpoint     Endpoint
+
+	// Client is the http client used to send requests to
+	// the token endpoints. If nil, the default client is used.
+	Client *http.Client
+}
+
+// AuthCodeURL returns the url of the consent page that asks
+// the user to authorize the application. The state is used
+// to protect against cross-site request forgery, and must be
+// verified when handling the redirect. If the verifier is not
+// empty, the url includes the S256 PKCE code challenge.
+func (c *Config) AuthCodeURL(state, verifier string) string {
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.ClientID)
+	if c.RedirectURL != "" {
+		params.Set("redirect_uri", c.RedirectURL)
+	}
+	if len(c.Scopes) != 0 {
+		params.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	if state != "" {
+		params.Set("state", state)
+	}
+	if verifier != "" {
+		params.Set("code_challenge", Challenge(verifier))
+		params.Set("code_challenge_method", "S256")
+	}
+	if strings.Contains(c.Endpoint.AuthURL, "?") {
+		return c.Endpoint.AuthURL + "&" + params.Encode()
+	}
+	return c.Endpoint.AuthURL + "?" + params.Encode()
+}
+
+// Exchange exchanges the authorization code for a token. The
+// verifier must match the verifier used to create the auth
+// code url, or be empty if PKCE is not used.
+func (c *Config) Exchange(ctx context.Context, code, verifier string) (*scm.Token, error) {
+	params := url.Values{}
+	params.Set("grant_type", "authorization_code")
+	params.Set("code", code)
+	if c.RedirectURL != "" {
+		params.Set("redirect_uri", c.RedirectURL)
+	}
+	if verifier != "" {
+		params.Set("code_verifier", verifier)
+	}
+	return c.token(ctx, params)
+}
+
+// Refresh exchanges the refresh token for a new token.
+func (c *Config) Refresh(ctx context.Context, refresh string) (*scm.Token, error) {
+	params := url.Values{}
+	params.Set("grant_type", "refresh_token")
+	params.Set("refresh_token", refresh)
+	return c.token(ctx, params)
+}
+
+// token requests a token from the token endpoint.
+func (c *Config) token(ctx context.Context, params url.Values) (*scm.Token, error) {
+	out := new(tokenGrant)
+	err := c.post(ctx, c.Endpoint.TokenURL, params, out)
+	if err != nil {
+		return nil, err
+	}
+	token := &scm.Token{
+		Token:   out.Access,
+		Refresh: out.Refresh,
+	}
+	if out.Expires != 0 {
+		token.Expires = time.Now().Add(
+			time.Duration(out.Expires) * time.Second,
+		)
+	}
+	return token, nil
+}
+
+// post sends the form encoded parameters, including the
+// client credentials, and decodes the json response.
+func (c *Config) post(ctx context.Context, endpoint string, params url.Values, out interface{}) error {
+	if c.Endpoint.AuthStyle == AuthStyleInParams {
+		params.Set("client_id", c.ClientID)
+		if c.ClientSecret != "" {
+			params.Set("client_secret", c.ClientSecret)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	if c.Endpoint.AuthStyle == AuthStyleInHeader {
+		req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	raw := json.RawMessage{}
+	if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		if res.StatusCode > 299 {
+			return &Error{Code: http.StatusText(res.StatusCode)}
+		}
+		return err
+	}
+
+	// some providers, including GitHub, return errors
+	// with a 200 status code.
+	e := new(Error)
+	if err := json.Unmarshal(raw, e); err == nil && e.Code != "" {
+		return e
+	}
+	if res.StatusCode > 299 {
+		return &Error{Code: http.StatusText(res.StatusCode)}
+	}
+	return json.Unmarshal(raw, out)
+}
+
+// client returns the http client. If no client is
+// configured, the default client is returned.
+func (c *Config) client() *http.Client {
+	if c.Client != nil {
+		return c.Client
+	}
+	return http.DefaultClient
+}
+
+// GenerateState returns a random state value used to protect
+// the authorization request against cross-site request forgery.
+func GenerateState() string {
+	return random(16)
+}
+
+// GenerateVerifier returns a random PKCE code verifier.
+func GenerateVerifier() string {
+	return random(32)
+}
+
+// Challenge returns the S256 PKCE code challenge for the
+// code verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// random returns a url safe string encoding of n random bytes.
+func random(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// tokenGrant is the token returned by the token endpoint.
+type tokenGrant struct {
+	Access  string `json:"access_token"`
+	Refresh string `json:"refresh_token"`
+	Expires int64  `json:"expires_in"`
+}
+
+// Error is the error returned by the token endpoints.
+type Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+	URI         string `json:"error_uri"`
+}
+
+func (e *Error) Error() string {
+	if e.Description != "" {
+		return e.Description
+	}
+	return e.Code
+}
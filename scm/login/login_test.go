@@ -0,0 +1,172 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package login
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAuthCodeURL(t *testing.T) {
+	c := &Config{
+		ClientID:    "dafe3804960dab",
+		RedirectURL: "http://localhost:8080/callback",
+		Scopes:      []string{"repo", "read:user"},
+		Endpoint:    GitHub(""),
+	}
+	raw := c.AuthCodeURL("af0ifjsldkj", "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := u.Scheme+"://"+u.Host+u.Path, "https://github.com/login/oauth/authorize"; got != want {
+		t.Errorf("Want auth url %q, got %q", want, got)
+	}
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             "dafe3804960dab",
+		"redirect_uri":          "http://localhost:8080/callback",
+		"scope":                 "repo read:user",
+		"state":                 "af0ifjsldkj",
+		"code_challenge":        "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		"code_challenge_method": "S256",
+	}
+	for k, v := range want {
+		if got := u.Query().Get(k); got != v {
+			t.Errorf("Want %s %q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestAuthCodeURL_NoPKCE(t *testing.T) {
+	c := &Config{
+		ClientID: "dafe3804960dab",
+		Endpoint: Gitea("https://try.gitea.io/"),
+	}
+	u, _ := url.Parse(c.AuthCodeURL("af0ifjsldkj", ""))
+	if got, want := u.Path, "/login/oauth/authorize"; got != want {
+		t.Errorf("Want path %q, got %q", want, got)
+	}
+	if u.Query().Get("code_challenge") != "" {
+		t.Errorf("Expect no code challenge")
+	}
+}
+
+func TestExchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		want := map[string]string{
+			"grant_type":    "authorization_code",
+			"code":          "SplxlOBeZQQYbYS6WxSbIA",
+			"code_verifier": "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+			"client_id":     "dafe3804960dab",
+			"client_secret": "20e651849b1f12",
+			"redirect_uri":  "http://localhost:8080/callback",
+		}
+		for k, v := range want {
+			if got := r.PostForm.Get(k); got != v {
+				t.Errorf("Want %s %q, got %q", k, v, got)
+			}
+		}
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3","refresh_token":"3a2bfce4cb9b0f","expires_in":7200,"token_type":"bearer"}`)
+	}))
+	defer server.Close()
+
+	c := &Config{
+		ClientID:     "dafe3804960dab",
+		ClientSecret: "20e651849b1f12",
+		RedirectURL:  "http://localhost:8080/callback",
+		Endpoint:     GitLab(server.URL),
+	}
+	token, err := c.Exchange(context.Background(), "SplxlOBeZQQYbYS6WxSbIA", "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := token.Token, "9698fa6a8113b3"; got != want {
+		t.Errorf("Want access token %q, got %q", want, got)
+	}
+	if got, want := token.Refresh, "3a2bfce4cb9b0f"; got != want {
+		t.Errorf("Want refresh token %q, got %q", want, got)
+	}
+	if token.Expires.IsZero() {
+		t.Errorf("Expect token expiry set")
+	}
+}
+
+func TestExchange_BasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if user != "dafe3804960dab" || pass != "20e651849b1f12" {
+			t.Errorf("Expect client credentials in authorization header")
+		}
+		if r.FormValue("client_secret") != "" {
+			t.Errorf("Expect client secret omitted from parameters")
+		}
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3"}`)
+	}))
+	defer server.Close()
+
+	endpoint := Bitbucket()
+	endpoint.TokenURL = server.URL
+	c := &Config{
+		ClientID:     "dafe3804960dab",
+		ClientSecret: "20e651849b1f12",
+		Endpoint:     endpoint,
+	}
+	token, err := c.Exchange(context.Background(), "SplxlOBeZQQYbYS6WxSbIA", "")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if !token.Expires.IsZero() {
+		t.Errorf("Expect no token expiry")
+	}
+}
+
+func TestExchange_Error(t *testing.T) {
+	// github returns errors with a 200 status code.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":"bad_verification_code","error_description":"The code passed is incorrect or expired.","error_uri":"https://docs.github.com"}`)
+	}))
+	defer server.Close()
+
+	c := &Config{
+		ClientID: "dafe3804960dab",
+		Endpoint: GitHub(server.URL),
+	}
+	_, err := c.Exchange(context.Background(), "SplxlOBeZQQYbYS6WxSbIA", "")
+	e, ok := err.(*Error)
+	if !ok {
+		t.Errorf("Expect *Error, got %v", err)
+		return
+	}
+	if got, want := e.Code, "bad_verification_code"; got != want {
+		t.Errorf("Want error code %q, got %q", want, got)
+	}
+	if got, want := e.Error(), "The code passed is incorrect or expired."; got != want {
+		t.Errorf("Want error message %q, got %q", want, got)
+	}
+}
+
+func TestChallenge(t *testing.T) {
+	// test vector from rfc 7636 appendix b.
+	got := Challenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	if want := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"; got != want {
+		t.Errorf("Want challenge %q, got %q", want, got)
+	}
+	if len(GenerateVerifier()) < 43 {
+		t.Errorf("Expect verifier of at least 43 characters")
+	}
+	if GenerateState() == GenerateState() {
+		t.Errorf("Expect random state")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redacted is the value used to replace sensitive
+// header values.
+const redacted = "REDACTED"
+
+// sensitiveHeaders is the list of headers that contain
+// credentials and are redacted before being observed.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Private-Token",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+type (
+	// RequestEvent describes an outgoing api request.
+	RequestEvent struct {
+		Driver Driver
+		Method string
+
+		// Path is the request path, excluding the query.
+		Path string
+
+		// Template is the request path with numeric ids and
+		// commit shas replaced with placeholders. It still
+		// includes the owner, repository, branch and file
+		// names, so it is not suitable as a metric label.
+		Template string
+
+		// Header is a copy of the request headers with the
+		// credentials redacted.
+		Header http.Header
+
+		// Wait is the time spent waiting for the client
+		// Limiter before the request is sent.
+		Wait time.Duration
+	}
+
+	// ResponseEvent describes the result of an api request.
+	ResponseEvent struct {
+		Request RequestEvent
+		Status  int
+		ID      string
+		Rate    Rate
+		Latency time.Duration
+		Err     error
+	}
+
+	// Observer is notified before and after each api request,
+	// and can be used to instrument the client with logging,
+	// metrics and tracing.
+	Observer interface {
+		// Before is invoked before the request is sent. The
+		// returned context is used to send the request, and
+		// is passed to After.
+		Before(context.Context, *RequestEvent) context.Context
+
+		// After is invoked once the response body is closed,
+		// at which point the driver has parsed the response
+		// id and rate limit, or when the request fails.
+		After(context.Context, *ResponseEvent)
+	}
+)
+
+// MultiObserver returns an Observer that notifies each of
+// the observers in order.
+func MultiObserver(observers ...Observer) Observer {
+	return multiObserver(observers)
+}
+
+type multiObserver []Observer
+
+func (m multiObserver) Before(ctx context.Context, event *RequestEvent) context.Context {
+	for _, o := range m {
+		ctx = o.Before(ctx, event)
+	}
+	return ctx
+}
+
+func (m multiObserver) After(ctx context.Context, event *ResponseEvent) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].After(ctx, event)
+	}
+}
+
+// RedactHeader returns a copy of the http headers with the
+// credentials redacted.
+func RedactHeader(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	out := header.Clone()
+	for _, key := range sensitiveHeaders {
+		if _, ok := out[key]; ok {
+			out[key] = []string{redacted}
+		}
+	}
+	return out
+}
+
+// pathTemplate returns the request path with numeric ids
+// and commit shas replaced with placeholders.
+func pathTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i != -1 {
+		path = path[:i]
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		switch {
+		case isNumeric(s):
+			segments[i] = "{id}"
+		case isCommitSha(s):
+			segments[i] = "{sha}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isNumeric returns true if the string is a number.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isCommitSha returns true if the string is a sha1 or
+// sha256 commit hash.
+func isCommitSha(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// observedBody wraps the response body and notifies the
+// observer when the body is closed.
+type observedBody struct {
+	io.ReadCloser
+	once  sync.Once
+	after func()
+}
+
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.after)
+	return err
+}
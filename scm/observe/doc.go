@@ -0,0 +1,11 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package observe provides scm.Observer implementations
+// used to instrument the client.
+//
+// The OpenTelemetry adapter is provided by the separate
+// github.com/drone/go-scm/scm/observe/otel module, so that
+// the core module does not depend on OpenTelemetry.
+package observe
@@ -0,0 +1,157 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package otel provides an scm.Observer that records each
+// api request as an OpenTelemetry span and metric.
+//
+// This package is a separate module so that the core
+// module does not depend on OpenTelemetry.
+package otel
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/drone/go-scm/scm"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentation is the instrumentation scope name.
+const instrumentation = "github.com/drone/go-scm/scm/observe/otel"
+
+// Option configures the observer.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the tracer provider. The global
+// tracer provider is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider. The global
+// meter provider is used by default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// New returns an Observer that creates a client span for
+// each api request, and records the request duration,
+// limiter wait time and remaining rate limit as metrics.
+func New(opts ...Option) (scm.Observer, error) {
+	c := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	meter := c.meterProvider.Meter(instrumentation)
+	duration, err := meter.Float64Histogram(
+		"scm.client.request.duration",
+		metric.WithDescription("Duration of scm api requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	wait, err := meter.Float64Histogram(
+		"scm.client.limiter.wait",
+		metric.WithDescription("Time spent waiting for the client limiter before sending scm api requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := meter.Int64Gauge(
+		"scm.client.rate_limit.remaining",
+		metric.WithDescription("Remaining scm api requests in the current rate limit window."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &observer{
+		tracer:    c.tracerProvider.Tracer(instrumentation),
+		duration:  duration,
+		wait:      wait,
+		remaining: remaining,
+	}, nil
+}
+
+type observer struct {
+	tracer    trace.Tracer
+	duration  metric.Float64Histogram
+	wait      metric.Float64Histogram
+	remaining metric.Int64Gauge
+}
+
+func (o *observer) Before(ctx context.Context, event *scm.RequestEvent) context.Context {
+	o.wait.Record(ctx, event.Wait.Seconds(), metric.WithAttributes(
+		attribute.String("scm.driver", event.Driver.String()),
+	))
+	ctx, _ = o.tracer.Start(ctx, event.Method+" "+event.Template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("scm.driver", event.Driver.String()),
+			attribute.String("http.request.method", event.Method),
+			attribute.String("url.path", event.Path),
+			attribute.String("url.template", event.Template),
+			attribute.Float64("scm.limiter.wait", event.Wait.Seconds()),
+		),
+	)
+	return ctx
+}
+
+func (o *observer) After(ctx context.Context, event *scm.ResponseEvent) {
+	// the template includes the repository, branch and file
+	// names, so it is only recorded on the span, and not as
+	// a metric attribute, to bound the metric cardinality.
+	attrs := []attribute.KeyValue{
+		attribute.String("scm.driver", event.Request.Driver.String()),
+		attribute.String("http.request.method", event.Request.Method),
+	}
+	if event.Status != 0 {
+		attrs = append(attrs, attribute.Int("http.response.status_code", event.Status))
+	}
+	if event.Err != nil {
+		attrs = append(attrs, attribute.String("error.type", "request"))
+	} else if event.Status > 399 {
+		attrs = append(attrs, attribute.String("error.type", strconv.Itoa(event.Status)))
+	}
+	o.duration.Record(ctx, event.Latency.Seconds(), metric.WithAttributes(attrs...))
+	if event.Rate.Limit != 0 {
+		o.remaining.Record(ctx, int64(event.Rate.Remaining), metric.WithAttributes(
+			attribute.String("scm.driver", event.Request.Driver.String()),
+		))
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attrs...)
+	if event.ID != "" {
+		span.SetAttributes(attribute.String("scm.request_id", event.ID))
+	}
+	switch {
+	case event.Err != nil:
+		span.RecordError(event.Err)
+		span.SetStatus(codes.Error, event.Err.Error())
+	case event.Status > 399:
+		span.SetStatus(codes.Error, "")
+	}
+	span.End()
+}
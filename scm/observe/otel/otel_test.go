@@ -0,0 +1,102 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package otel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestObserver(t *testing.T) {
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	o, err := New(
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	req := &scm.RequestEvent{
+		Driver:   scm.DriverGithub,
+		Method:   "GET",
+		Path:     "/repos/octocat/hello-world/pulls/1347",
+		Template: "repos/octocat/hello-world/pulls/{id}",
+	}
+	ctx := o.Before(context.Background(), req)
+	o.After(ctx, &scm.ResponseEvent{
+		Request: *req,
+		Status:  404,
+		ID:      "DD0E:6011:12F21A8:1926790:5A2064E2",
+		Rate:    scm.Rate{Limit: 60, Remaining: 59, Reset: 1512076018},
+		Latency: 25 * time.Millisecond,
+	})
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Errorf("Want 1 span, got %d", len(ended))
+		return
+	}
+	span := ended[0]
+	if got, want := span.Name(), "GET repos/octocat/hello-world/pulls/{id}"; got != want {
+		t.Errorf("Want span name %q, got %q", want, got)
+	}
+	if got, want := span.Status().Code, codes.Error; got != want {
+		t.Errorf("Want span status %v, got %v", want, got)
+	}
+	found := false
+	for _, attr := range span.Attributes() {
+		if attr.Key == "http.response.status_code" && attr.Value.AsInt64() == 404 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Want status code attribute")
+	}
+	found = false
+	for _, attr := range span.Attributes() {
+		if attr.Key == "url.template" && attr.Value.AsString() == req.Template {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Want url.template span attribute")
+	}
+
+	rm := metricdata.ResourceMetrics{}
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Error(err)
+		return
+	}
+	metrics := map[string]metricdata.Metrics{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+	hist, ok := metrics["scm.client.request.duration"].Data.(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 1 || hist.DataPoints[0].Count != 1 {
+		t.Errorf("Want request duration recorded")
+	} else if _, ok := hist.DataPoints[0].Attributes.Value(attribute.Key("url.template")); ok {
+		t.Errorf("Want url.template excluded from the metric attributes")
+	}
+	gauge, ok := metrics["scm.client.rate_limit.remaining"].Data.(metricdata.Gauge[int64])
+	if !ok || len(gauge.DataPoints) != 1 || gauge.DataPoints[0].Value != 59 {
+		t.Errorf("Want remaining rate limit recorded")
+	}
+}
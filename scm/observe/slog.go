@@ -0,0 +1,66 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.21
+// +build go1.21
+
+package observe
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// Slog returns an Observer that logs each api request using
+// the structured logger. Successful requests are logged at
+// the debug level, failed requests at the warn level.
+func Slog(logger *slog.Logger) scm.Observer {
+	return &slogObserver{logger: logger}
+}
+
+type slogObserver struct {
+	logger *slog.Logger
+}
+
+func (o *slogObserver) Before(ctx context.Context, event *scm.RequestEvent) context.Context {
+	return ctx
+}
+
+func (o *slogObserver) After(ctx context.Context, event *scm.ResponseEvent) {
+	attrs := []slog.Attr{
+		slog.String("driver", event.Request.Driver.String()),
+		slog.String("method", event.Request.Method),
+		slog.String("path", event.Request.Path),
+		slog.String("template", event.Request.Template),
+		slog.Duration("latency", event.Latency),
+	}
+	if event.Request.Wait > 0 {
+		attrs = append(attrs, slog.Duration("wait", event.Request.Wait))
+	}
+	if event.Status != 0 {
+		attrs = append(attrs, slog.Int("status", event.Status))
+	}
+	if event.ID != "" {
+		attrs = append(attrs, slog.String("request_id", event.ID))
+	}
+	if event.Rate.Limit != 0 {
+		attrs = append(attrs,
+			slog.Int("rate_limit", event.Rate.Limit),
+			slog.Int("rate_remaining", event.Rate.Remaining),
+			slog.Int64("rate_reset", event.Rate.Reset),
+		)
+	}
+
+	level := slog.LevelDebug
+	switch {
+	case event.Err != nil:
+		level = slog.LevelWarn
+		attrs = append(attrs, slog.String("error", event.Err.Error()))
+	case event.Status > 399:
+		level = slog.LevelWarn
+	}
+	o.logger.LogAttrs(ctx, level, "scm request", attrs...)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.21
+// +build go1.21
+
+package observe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestSlog(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	o := Slog(logger)
+	ctx := o.Before(context.Background(), &scm.RequestEvent{})
+	o.After(ctx, &scm.ResponseEvent{
+		Request: scm.RequestEvent{
+			Driver:   scm.DriverGithub,
+			Method:   "GET",
+			Path:     "/repos/octocat/hello-world/issues/1",
+			Template: "repos/octocat/hello-world/issues/{id}",
+		},
+		Status:  200,
+		ID:      "DD0E:6011:12F21A8:1926790:5A2064E2",
+		Rate:    scm.Rate{Limit: 60, Remaining: 59, Reset: 1512076018},
+		Latency: 25 * time.Millisecond,
+	})
+
+	got := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Error(err)
+		return
+	}
+	want := map[string]interface{}{
+		"level":          "DEBUG",
+		"msg":            "scm request",
+		"driver":         "github",
+		"method":         "GET",
+		"template":       "repos/octocat/hello-world/issues/{id}",
+		"status":         float64(200),
+		"request_id":     "DD0E:6011:12F21A8:1926790:5A2064E2",
+		"rate_remaining": float64(59),
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("Want %s %v, got %v", k, v, got[k])
+		}
+	}
+}
+
+func TestSlog_Error(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+	Slog(logger).After(context.Background(), &scm.ResponseEvent{
+		Request: scm.RequestEvent{
+			Driver: scm.DriverGitlab,
+			Method: "GET",
+			Path:   "/api/v4/user",
+		},
+		Err: errors.New("connection refused"),
+	})
+
+	got := map[string]interface{}{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Error(err)
+		return
+	}
+	if got["level"] != "WARN" {
+		t.Errorf("Want level WARN, got %v", got["level"])
+	}
+	if got["error"] != "connection refused" {
+		t.Errorf("Want error logged, got %v", got["error"])
+	}
+}
@@ -6,6 +6,7 @@ package scm
 
 import (
 	"context"
+	"net/http"
 	"time"
 )
 
@@ -59,6 +60,30 @@ type (
 		NativeEvents []string
 	}
 
+	// HookDelivery represents a single delivery attempt
+	// of a repository webhook.
+	HookDelivery struct {
+		ID         string
+		GUID       string
+		Event      string
+		Action     string
+		Success    bool
+		Redelivery bool
+		StatusCode int
+		Status     string
+		Duration   time.Duration
+		Created    time.Time
+		Request    HookMessage
+		Response   HookMessage
+	}
+
+	// HookMessage represents the http request sent, or the
+	// http response received, during a hook delivery.
+	HookMessage struct {
+		Header http.Header
+		Body   string
+	}
+
 	// HookEvents represents supported hook events.
 	HookEvents struct {
 		Branch             bool
@@ -146,6 +171,20 @@ type (
 
 		// DeleteHook deletes a repository hook.
 		DeleteHook(context.Context, string, string) (*Response, error)
+
+		// FindHookDelivery returns a repository hook delivery,
+		// including the request and response details.
+		FindHookDelivery(context.Context, string, string, string) (*HookDelivery, *Response, error)
+
+		// ListHookDeliveries returns a list of recent
+		// repository hook deliveries.
+		ListHookDeliveries(context.Context, string, string, ListOptions) ([]*HookDelivery, *Response, error)
+
+		// RedeliverHook redelivers a repository hook delivery.
+		RedeliverHook(context.Context, string, string, string) (*Response, error)
+
+		// PingHook sends a test event to a repository hook.
+		PingHook(context.Context, string, string) (*Response, error)
 	}
 )
 
@@ -18,6 +18,17 @@ type (
 		Expires time.Time
 	}
 
+	// TokenInfo represents the metadata of the token used
+	// to authenticate the client.
+	TokenInfo struct {
+		ID      string
+		Name    string
+		Type    string
+		Scopes  []string // nil if the scopes are unknown
+		Expires time.Time
+		User    User
+	}
+
 	// TokenSource returns a token.
 	TokenSource interface {
 		Token(context.Context) (*Token, error)
@@ -0,0 +1,412 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package githubapp provides token sources that authenticate
+// requests as a GitHub App, or as an installation of a GitHub
+// App. The token sources can be used with the oauth2.Transport
+// to authorize GitHub client requests:
+//
+//	app := &githubapp.App{
+//		ID:         "1234",
+//		PrivateKey: key,
+//	}
+//	client := github.NewDefault()
+//	client.Client = &http.Client{
+//		Transport: &oauth2.Transport{
+//			Source: app.RepoInstallation("octocat/hello-world"),
+//		},
+//	}
+package githubapp
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+const (
+	// jwtLifetime is the lifetime of the json web token used
+	// to authenticate as the app. GitHub rejects tokens that
+	// are valid for more than ten minutes.
+	jwtLifetime = 9 * time.Minute
+
+	// clockSkew determines how far in the past the json web
+	// token is issued. It is used to avoid rejected tokens
+	// due to client-server time mismatches.
+	clockSkew = time.Minute
+
+	// expiryDelta determines how earlier a token should be
+	// considered expired than its actual expiration time.
+	expiryDelta = time.Minute
+)
+
+// App provides json web tokens that authenticate as a GitHub
+// App, and installation access tokens that authenticate as an
+// installation of the GitHub App. Tokens are cached until
+// shortly before they expire.
+//
+// App is safe for concurrent use by multiple goroutines.
+type App struct {
+	// ID is the GitHub App ID or Client ID.
+	ID string
+
+	// PrivateKey is the private key used to sign the json
+	// web tokens.
+	PrivateKey *rsa.PrivateKey
+
+	// Server is the GitHub API address. If empty, the
+	// https://api.github.com address is used.
+	Server string
+
+	// Client is the http client used to request installation
+	// access tokens. If nil, the default client is used.
+	Client *http.Client
+
+	// mu guards the caches and in-flight calls. It is never
+	// held across http requests, so that a slow installation
+	// does not block requests for other installations.
+	mu       sync.Mutex
+	jwt      *scm.Token
+	tokens   map[int64]*scm.Token
+	installs map[string]int64
+	calls    map[string]*call
+
+	// now returns the current time, and can be replaced
+	// for unit testing purposes.
+	now func() time.Time
+}
+
+// Token returns a json web token that authenticates as the
+// GitHub App. The json web token can only be used to access
+// the app and installation endpoints.
+func (a *App) Token(ctx context.Context) (*scm.Token, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	token, err := a.appToken()
+	if err != nil {
+		return nil, err
+	}
+	copied := *token
+	return &copied, nil
+}
+
+// InstallationToken returns an access token for the
+// installation with the given id. Concurrent requests for
+// the same installation are collapsed into a single request
+// to the access token endpoint. If the installation is not
+// found, the cached installation ids that resolve to the
+// installation are dropped, since the app was uninstalled
+// or reinstalled.
+func (a *App) InstallationToken(ctx context.Context, id int64) (*scm.Token, error) {
+	a.mu.Lock()
+	token, ok := a.tokens[id]
+	a.mu.Unlock()
+	if ok && !a.expired(token) {
+		copied := *token
+		return &copied, nil
+	}
+	key := fmt.Sprintf("token/%d", id)
+	v, err := a.once(ctx, key, func() (interface{}, error) {
+		// the token may have been refreshed by a call that
+		// completed before this call started.
+		a.mu.Lock()
+		token, ok := a.tokens[id]
+		a.mu.Unlock()
+		if ok && !a.expired(token) {
+			return token, nil
+		}
+		path := fmt.Sprintf("app/installations/%d/access_tokens", id)
+		out := new(accessToken)
+		if err := a.do(ctx, "POST", path, out); err != nil {
+			if err == scm.ErrNotFound {
+				a.forget(id)
+			}
+			return nil, err
+		}
+		token = &scm.Token{
+			Token:   out.Token,
+			Expires: out.ExpiresAt,
+		}
+		a.mu.Lock()
+		if a.tokens == nil {
+			a.tokens = map[int64]*scm.Token{}
+		}
+		a.tokens[id] = token
+		a.mu.Unlock()
+		return token, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the cached token is shared, so the caller receives a
+	// copy that can be safely modified.
+	copied := *v.(*scm.Token)
+	return &copied, nil
+}
+
+// FindRepoInstallation returns the id of the app installation
+// that has access to the named repository.
+func (a *App) FindRepoInstallation(ctx context.Context, repo string) (int64, error) {
+	return a.findInstallation(ctx, fmt.Sprintf("repos/%s/installation", repo))
+}
+
+// FindOrgInstallation returns the id of the app installation
+// for the named organization.
+func (a *App) FindOrgInstallation(ctx context.Context, org string) (int64, error) {
+	return a.findInstallation(ctx, fmt.Sprintf("orgs/%s/installation", org))
+}
+
+// FindUserInstallation returns the id of the app installation
+// for the named user account.
+func (a *App) FindUserInstallation(ctx context.Context, user string) (int64, error) {
+	return a.findInstallation(ctx, fmt.Sprintf("users/%s/installation", user))
+}
+
+// Installation returns a TokenSource that returns access
+// tokens for the installation with the given id.
+func (a *App) Installation(id int64) scm.TokenSource {
+	return &installationSource{app: a, find: func(context.Context) (int64, error) {
+		return id, nil
+	}}
+}
+
+// RepoInstallation returns a TokenSource that returns access
+// tokens for the app installation that has access to the
+// named repository. The installation id is resolved on the
+// first request.
+func (a *App) RepoInstallation(repo string) scm.TokenSource {
+	return &installationSource{app: a, find: func(ctx context.Context) (int64, error) {
+		return a.FindRepoInstallation(ctx, repo)
+	}}
+}
+
+// OrgInstallation returns a TokenSource that returns access
+// tokens for the app installation for the named organization.
+// The installation id is resolved on the first request.
+func (a *App) OrgInstallation(org string) scm.TokenSource {
+	return &installationSource{app: a, find: func(ctx context.Context) (int64, error) {
+		return a.FindOrgInstallation(ctx, org)
+	}}
+}
+
+// findInstallation returns the installation id returned by
+// the endpoint. The installation id is cached until the
+// access token endpoint reports the installation is not
+// found.
+func (a *App) findInstallation(ctx context.Context, path string) (int64, error) {
+	v, err := a.once(ctx, "install/"+path, func() (interface{}, error) {
+		a.mu.Lock()
+		id, ok := a.installs[path]
+		a.mu.Unlock()
+		if ok {
+			return id, nil
+		}
+		out := new(installation)
+		if err := a.do(ctx, "GET", path, out); err != nil {
+			return nil, err
+		}
+		a.mu.Lock()
+		if a.installs == nil {
+			a.installs = map[string]int64{}
+		}
+		a.installs[path] = out.ID
+		a.mu.Unlock()
+		return out.ID, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return v.(int64), nil
+}
+
+// forget drops the cached access token and installation
+// ids of the installation.
+func (a *App) forget(id int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.tokens, id)
+	for path, v := range a.installs {
+		if v == id {
+			delete(a.installs, path)
+		}
+	}
+}
+
+// once invokes fn and returns its result. If a call with
+// the same key is in progress, the caller waits for the
+// in-flight call and receives its result instead.
+func (a *App) once(ctx context.Context, key string, fn func() (interface{}, error)) (interface{}, error) {
+	a.mu.Lock()
+	if c, ok := a.calls[key]; ok {
+		a.mu.Unlock()
+		select {
+		case <-c.done:
+			return c.val, c.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	c := &call{done: make(chan struct{})}
+	if a.calls == nil {
+		a.calls = map[string]*call{}
+	}
+	a.calls[key] = c
+	a.mu.Unlock()
+
+	c.val, c.err = fn()
+
+	a.mu.Lock()
+	delete(a.calls, key)
+	a.mu.Unlock()
+	close(c.done)
+	return c.val, c.err
+}
+
+// appToken returns the cached json web token, signing a
+// new token if missing or expired. The caller must hold
+// the lock.
+func (a *App) appToken() (*scm.Token, error) {
+	if a.jwt != nil && !a.expired(a.jwt) {
+		return a.jwt, nil
+	}
+	if a.PrivateKey == nil {
+		return nil, ErrInvalidKey
+	}
+	now := a.clock()
+	issued := now.Add(-clockSkew)
+	expires := now.Add(jwtLifetime)
+	signed, err := sign(a.PrivateKey, a.ID, issued, expires)
+	if err != nil {
+		return nil, err
+	}
+	a.jwt = &scm.Token{
+		Token:   signed,
+		Expires: expires,
+	}
+	return a.jwt, nil
+}
+
+// do sends an api request authenticated as the app and
+// decodes the json response. The caller must not hold the
+// lock.
+func (a *App) do(ctx context.Context, method, path string, out interface{}) error {
+	a.mu.Lock()
+	token, err := a.appToken()
+	a.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, a.server()+"/"+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	res, err := a.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return scm.ErrNotFound
+	}
+	if res.StatusCode > 299 {
+		out := new(apiError)
+		_ = json.NewDecoder(res.Body).Decode(out)
+		if out.Message == "" {
+			out.Message = http.StatusText(res.StatusCode)
+		}
+		return out
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// expired reports whether the token is expired.
+func (a *App) expired(token *scm.Token) bool {
+	if token.Expires.IsZero() {
+		return false
+	}
+	return token.Expires.Add(-expiryDelta).
+		Before(a.clock())
+}
+
+// clock returns the current time.
+func (a *App) clock() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+	return time.Now()
+}
+
+// server returns the api address. If no address is
+// configured, the GitHub.com api address is returned.
+func (a *App) server() string {
+	if a.Server == "" {
+		return "https://api.github.com"
+	}
+	return strings.TrimSuffix(a.Server, "/")
+}
+
+// client returns the http client. If no client is
+// configured, the default client is returned.
+func (a *App) client() *http.Client {
+	if a.Client != nil {
+		return a.Client
+	}
+	return http.DefaultClient
+}
+
+// installationSource is a TokenSource that returns access
+// tokens for an app installation.
+type installationSource struct {
+	app  *App
+	find func(context.Context) (int64, error)
+}
+
+func (s *installationSource) Token(ctx context.Context) (*scm.Token, error) {
+	id, err := s.find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.app.InstallationToken(ctx, id)
+}
+
+// call represents an in-flight call.
+type call struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// accessToken is the installation access token returned
+// by the access token endpoint.
+type accessToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// installation is the app installation returned by the
+// installation endpoints.
+type installation struct {
+	ID int64 `json:"id"`
+}
+
+// apiError is the error returned when the api returns a
+// non-2XX HTTP status code.
+type apiError struct {
+	Message string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubapp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"time"
+)
+
+// ErrInvalidKey is returned when the private key cannot be
+// decoded or is not an RSA private key.
+var ErrInvalidKey = errors.New("githubapp: invalid rsa private key")
+
+// ParsePrivateKey parses a PEM encoded RSA private key, in
+// either PKCS1 or PKCS8 format, as downloaded from the
+// GitHub App settings page.
+func ParsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidKey
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// claims represents the registered claims included in the
+// json web token used to authenticate as a GitHub App.
+type claims struct {
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	Issuer    string `json:"iss"`
+}
+
+// sign returns a json web token signed with the RS256
+// algorithm, issued by the app and valid until expires.
+func sign(key *rsa.PrivateKey, issuer string, issued, expires time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(&claims{
+		IssuedAt:  issued.Unix(),
+		ExpiresAt: expires.Unix(),
+		Issuer:    issuer,
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := encodeSegment(header) + "." + encodeSegment(payload)
+	hashed := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + encodeSegment(signature), nil
+}
+
+// encodeSegment encodes a json web token segment using
+// unpadded base64 url encoding.
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubapp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+func TestSign(t *testing.T) {
+	issued := time.Unix(1700000000, 0)
+	expires := issued.Add(10 * time.Minute)
+	token, err := sign(testKey, "1234", issued, expires)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Errorf("Want 3 token segments, got %d", len(parts))
+		return
+	}
+
+	header := map[string]string{}
+	raw, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	_ = json.Unmarshal(raw, &header)
+	if got, want := header["alg"], "RS256"; got != want {
+		t.Errorf("Want alg %q, got %q", want, got)
+	}
+
+	payload := new(claims)
+	raw, _ = base64.RawURLEncoding.DecodeString(parts[1])
+	_ = json.Unmarshal(raw, payload)
+	if got, want := payload.Issuer, "1234"; got != want {
+		t.Errorf("Want issuer %q, got %q", want, got)
+	}
+	if got, want := payload.IssuedAt, issued.Unix(); got != want {
+		t.Errorf("Want issued at %d, got %d", want, got)
+	}
+	if got, want := payload.ExpiresAt, expires.Unix(); got != want {
+		t.Errorf("Want expires at %d, got %d", want, got)
+	}
+
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&testKey.PublicKey, crypto.SHA256, hashed[:], signature); err != nil {
+		t.Errorf("Expect valid signature, got %v", err)
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	pkcs1 := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(testKey),
+	})
+	key, err := ParsePrivateKey(pkcs1)
+	if err != nil {
+		t.Error(err)
+	} else if !key.Equal(testKey) {
+		t.Errorf("Expect PKCS1 key parsed")
+	}
+
+	der, _ := x509.MarshalPKCS8PrivateKey(testKey)
+	pkcs8 := pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: der,
+	})
+	key, err = ParsePrivateKey(pkcs8)
+	if err != nil {
+		t.Error(err)
+	} else if !key.Equal(testKey) {
+		t.Errorf("Expect PKCS8 key parsed")
+	}
+}
+
+func TestParsePrivateKey_Invalid(t *testing.T) {
+	_, err := ParsePrivateKey([]byte("not a key"))
+	if err != ErrInvalidKey {
+		t.Errorf("Expect invalid key error, got %v", err)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httpcache provides an http.RoundTripper that
+// makes conditional requests using the ETag and
+// Last-Modified response headers, and serves the cached
+// response when the server responds 304 Not Modified.
+//
+// GitHub does not count 304 responses against the rate
+// limit, which makes conditional requests well suited for
+// polling branches, commits and statuses.
+package httpcache
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httputil"
+	"sort"
+	"strings"
+
+	"github.com/drone/go-scm/scm/transport/internal"
+)
+
+// XFromCache is the header set on responses served from
+// the cache.
+const XFromCache = "X-From-Cache"
+
+// Cache stores serialized http responses by key.
+type Cache interface {
+	// Get returns the cached response and true, or false
+	// if the key is not cached.
+	Get(key string) ([]byte, bool)
+
+	// Set stores the response.
+	Set(key string, response []byte)
+
+	// Delete removes the response.
+	Delete(key string)
+}
+
+// Transport is an http.RoundTripper that caches responses
+// and revalidates them using conditional requests.
+//
+// The cache is keyed by url and credentials, so the
+// Transport must be the Base of the authenticating
+// transport, so that it observes the Authorization header.
+type Transport struct {
+	Base  http.RoundTripper
+	Cache Cache
+}
+
+// RoundTrip makes a conditional request if a cached
+// response exists, and returns the cached response if the
+// server responds 304 Not Modified.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	// only requests without side effects are cached, and
+	// requests that are already conditional are passed
+	// through to the caller unmodified.
+	if !cacheable(r) {
+		return t.base().RoundTrip(r)
+	}
+
+	key := cacheKey(r)
+	cached := t.lookup(key, r)
+
+	req := r
+	if cached != nil {
+		req = internal.CloneRequest(r)
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	res, err := t.base().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		res.Body.Close()
+		// the 304 response includes up-to-date headers,
+		// such as the rate limit, which replace the cached
+		// values.
+		for k, v := range res.Header {
+			switch k {
+			case "Content-Length", "Content-Type", "Transfer-Encoding":
+			default:
+				cached.Header[k] = v
+			}
+		}
+		cached.Header.Set(XFromCache, "1")
+		return cached, nil
+	}
+	if cached != nil {
+		cached.Body.Close()
+	}
+
+	switch {
+	case res.StatusCode != http.StatusOK:
+		// the cached response is no longer valid if the
+		// resource was moved or deleted.
+		if res.StatusCode < 500 && res.StatusCode != http.StatusTooManyRequests {
+			t.Cache.Delete(key)
+		}
+	case storable(res):
+		if raw, err := httputil.DumpResponse(res, true); err == nil {
+			t.Cache.Set(key, raw)
+		}
+	default:
+		t.Cache.Delete(key)
+	}
+	return res, nil
+}
+
+// lookup returns the cached response for the key, or nil.
+func (t *Transport) lookup(key string, r *http.Request) *http.Response {
+	raw, ok := t.Cache.Get(key)
+	if !ok {
+		return nil
+	}
+	res, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(raw)), r)
+	if err != nil {
+		t.Cache.Delete(key)
+		return nil
+	}
+	return res
+}
+
+// base returns the base transport. If no base transport
+// is configured, the default transport is returned.
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// cacheable returns true if the request can be served
+// from the cache.
+func cacheable(r *http.Request) bool {
+	if r.Method != "GET" && r.Method != "" {
+		return false
+	}
+	for _, h := range []string{"If-None-Match", "If-Modified-Since", "Range"} {
+		if r.Header.Get(h) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// storable returns true if the response can be stored in
+// the cache and revalidated.
+func storable(res *http.Response) bool {
+	if strings.Contains(res.Header.Get("Cache-Control"), "no-store") {
+		return false
+	}
+	return res.Header.Get("ETag") != "" ||
+		res.Header.Get("Last-Modified") != ""
+}
+
+// cacheKey returns the cache key for the request. The key
+// includes a hash of the credentials so that responses
+// are never shared between tokens, and the credentials
+// are never written to the cache in plain text.
+func cacheKey(r *http.Request) string {
+	h := sha256.New()
+	h.Write([]byte("Authorization:" + authorization(r) + "\n"))
+	for _, k := range []string{"Private-Token", "X-Api-Key", "Accept"} {
+		h.Write([]byte(k + ":" + r.Header.Get(k) + "\n"))
+	}
+	return r.URL.String() + "#" + hex.EncodeToString(h.Sum(nil))
+}
+
+// authorization returns the Authorization header used in
+// the cache key. OAuth1 signs every request with a unique
+// nonce and timestamp, so only the consumer key and token,
+// which identify the credentials, are used.
+func authorization(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "OAuth ") {
+		return header
+	}
+	var params []string
+	for _, param := range strings.Split(strings.TrimPrefix(header, "OAuth "), ",") {
+		param = strings.TrimSpace(param)
+		if strings.HasPrefix(param, "oauth_consumer_key=") ||
+			strings.HasPrefix(param, "oauth_token=") {
+			params = append(params, param)
+		}
+	}
+	sort.Strings(params)
+	return "OAuth " + strings.Join(params, ", ")
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httpcache
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/drone/go-scm/scm/transport"
+)
+
+func TestTransport(t *testing.T) {
+	var requests, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.Header.Get("If-None-Match") == `"644b5b0155e6404a9cc4bd9d8b1ae730"` {
+			atomic.AddInt32(&notModified, 1)
+			w.Header().Set("X-RateLimit-Remaining", "4998")
+			w.WriteHeader(304)
+			return
+		}
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"master"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &transport.BearerToken{
+			Token: "12345",
+			Base:  &Transport{Cache: NewMemoryCache(10)},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get(server.URL + "/repos/octocat/hello-world/branches/master")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		body, _ := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+
+		if got, want := res.StatusCode, 200; got != want {
+			t.Errorf("Want status code %d, got %d", want, got)
+		}
+		if got, want := string(body), `{"name":"master"}`; got != want {
+			t.Errorf("Want body %s, got %s", want, got)
+		}
+		if got, want := res.Header.Get("Content-Type"), "application/json"; got != want {
+			t.Errorf("Want content type %q, got %q", want, got)
+		}
+		if i == 0 && res.Header.Get(XFromCache) != "" {
+			t.Errorf("Want first response from server")
+		}
+		if i == 1 {
+			if res.Header.Get(XFromCache) == "" {
+				t.Errorf("Want second response from cache")
+			}
+			if got, want := res.Header.Get("X-RateLimit-Remaining"), "4998"; got != want {
+				t.Errorf("Want rate limit header updated to %s, got %s", want, got)
+			}
+		}
+	}
+	if got, want := requests, int32(2); got != want {
+		t.Errorf("Want %d requests, got %d", want, got)
+	}
+	if got, want := notModified, int32(1); got != want {
+		t.Errorf("Want %d conditional requests, got %d", want, got)
+	}
+}
+
+func TestTransport_Token(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("Expect cached response not shared between tokens")
+		}
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	cache := NewMemoryCache(10)
+	for _, token := range []string{"12345", "67890"} {
+		client := &http.Client{
+			Transport: &transport.BearerToken{
+				Token: token,
+				Base:  &Transport{Cache: cache},
+			},
+		}
+		res, err := client.Get(server.URL + "/user")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+	if got, want := cache.Len(), 2; got != want {
+		t.Errorf("Want %d cached responses, got %d", want, got)
+	}
+}
+
+func TestTransport_ApiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("Expect cached response not shared between api keys")
+		}
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	cache := NewMemoryCache(10)
+	client := &http.Client{Transport: &Transport{Cache: cache}}
+	for _, key := range []string{"pat.12345", "pat.67890"} {
+		req, _ := http.NewRequest("GET", server.URL+"/user", nil)
+		req.Header.Set("x-api-key", key)
+		res, err := client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+	if got, want := cache.Len(), 2; got != want {
+		t.Errorf("Want %d cached responses, got %d", want, got)
+	}
+}
+
+func TestTransport_OAuth1(t *testing.T) {
+	var conditional int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			atomic.AddInt32(&conditional, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	cache := NewMemoryCache(10)
+	client := &http.Client{Transport: &Transport{Cache: cache}}
+
+	// each request is signed with a new nonce and timestamp,
+	// which must not change the cache key.
+	for _, nonce := range []string{"7d8f3e4a", "b2c9a1f0"} {
+		req, _ := http.NewRequest("GET", server.URL+"/user", nil)
+		req.Header.Set("Authorization", `OAuth oauth_consumer_key="drone", oauth_nonce="`+nonce+`", oauth_signature="c2lnbmF0dXJl", oauth_timestamp="1700000000", oauth_token="a3b7e9"`)
+		res, err := client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		res.Body.Close()
+	}
+	if got, want := cache.Len(), 1; got != want {
+		t.Errorf("Want %d cached responses, got %d", want, got)
+	}
+	if got := atomic.LoadInt32(&conditional); got != 1 {
+		t.Errorf("Want the second request revalidated, got %d conditional requests", got)
+	}
+}
+
+func TestTransport_NotCached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"644b5b0155e6404a9cc4bd9d8b1ae730"`)
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	cache := NewMemoryCache(10)
+	client := &http.Client{Transport: &Transport{Cache: cache}}
+	res, err := client.Post(server.URL+"/user/repos", "application/json", nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	res.Body.Close()
+	if got := cache.Len(); got != 0 {
+		t.Errorf("Want post response not cached, got %d cached responses", got)
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	c.Get("a")
+	c.Set("c", []byte("3"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("Expect least recently used entry evicted")
+	}
+	if v, ok := c.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("Expect recently used entry retained")
+	}
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("Expect entry deleted")
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "httpcache")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	c := NewDiskCache(dir)
+	if _, ok := c.Get("https://api.github.com/user"); ok {
+		t.Errorf("Expect cache miss")
+	}
+	c.Set("https://api.github.com/user", []byte("HTTP/1.1 200 OK\r\n\r\n"))
+	if v, ok := c.Get("https://api.github.com/user"); !ok || string(v) != "HTTP/1.1 200 OK\r\n\r\n" {
+		t.Errorf("Expect cache hit")
+	}
+	c.Delete("https://api.github.com/user")
+	if _, ok := c.Get("https://api.github.com/user"); ok {
+		t.Errorf("Expect entry deleted")
+	}
+}
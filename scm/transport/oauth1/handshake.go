@@ -0,0 +1,204 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package oauth1
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// ErrMissingVerifier is returned when the authorization
+// callback does not include the token and verifier.
+var ErrMissingVerifier = errors.New("oauth1: missing oauth_token or oauth_verifier")
+
+// Endpoint represents the OAuth1 endpoints of a provider.
+type Endpoint struct {
+	RequestTokenURL string
+	AuthorizeURL    string
+	AccessTokenURL  string
+}
+
+// BitbucketServer returns the Bitbucket Server application
+// link endpoints for the server address.
+func BitbucketServer(server string) Endpoint {
+	server = strings.TrimSuffix(server, "/")
+	return Endpoint{
+		RequestTokenURL: server + "/plugins/servlet/oauth/request-token",
+		AuthorizeURL:    server + "/plugins/servlet/oauth/authorize",
+		AccessTokenURL:  server + "/plugins/servlet/oauth/access-token",
+	}
+}
+
+// Config provides the three-legged OAuth1 handshake used
+// to obtain an access token, according to RFC 5849 2. The
+// requests are signed with the RSA-SHA1 signature method.
+type Config struct {
+	// Consumer Key
+	ConsumerKey string
+
+	// Consumer Private Key
+	PrivateKey *rsa.PrivateKey
+
+	// CallbackURL is the address the user is redirected to
+	// after authorizing the request token. If empty, the
+	// out-of-band (oob) callback is used.
+	CallbackURL string
+
+	Endpoint Endpoint
+
+	// Client is the http client used to request tokens. If
+	// nil, the default client is used.
+	Client *http.Client
+
+	noncer noncer
+	clock  clock
+}
+
+// RequestToken represents the temporary credentials that
+// are exchanged for an access token once authorized.
+type RequestToken struct {
+	Token  string
+	Secret string
+}
+
+// RequestToken obtains a request token according to RFC
+// 5849 2.1.
+func (c *Config) RequestToken(ctx context.Context) (*RequestToken, error) {
+	callback := c.CallbackURL
+	if callback == "" {
+		callback = "oob"
+	}
+	values, err := c.post(ctx, c.Endpoint.RequestTokenURL, map[string]string{
+		"oauth_callback": callback,
+	})
+	if err != nil {
+		return nil, err
+	}
+	token := &RequestToken{
+		Token:  values.Get("oauth_token"),
+		Secret: values.Get("oauth_token_secret"),
+	}
+	if token.Token == "" {
+		return nil, errors.New("oauth1: response missing oauth_token")
+	}
+	return token, nil
+}
+
+// AuthorizeURL returns the url the user visits to authorize
+// the request token, according to RFC 5849 2.2.
+func (c *Config) AuthorizeURL(token *RequestToken) string {
+	params := url.Values{}
+	params.Set("oauth_token", token.Token)
+	if strings.Contains(c.Endpoint.AuthorizeURL, "?") {
+		return c.Endpoint.AuthorizeURL + "&" + params.Encode()
+	}
+	return c.Endpoint.AuthorizeURL + "?" + params.Encode()
+}
+
+// AccessToken exchanges the authorized request token and
+// verifier for an access token, according to RFC 5849 2.3.
+func (c *Config) AccessToken(ctx context.Context, token *RequestToken, verifier string) (*scm.Token, error) {
+	values, err := c.post(ctx, c.Endpoint.AccessTokenURL, map[string]string{
+		"oauth_token":    token.Token,
+		"oauth_verifier": verifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := &scm.Token{
+		Token: values.Get("oauth_token"),
+	}
+	if out.Token == "" {
+		return nil, errors.New("oauth1: response missing oauth_token")
+	}
+	if expires, _ := strconv.ParseInt(values.Get("oauth_expires_in"), 10, 64); expires > 0 {
+		out.Expires = time.Now().Add(
+			time.Duration(expires) * time.Second,
+		)
+	}
+	return out, nil
+}
+
+// ParseCallback returns the request token and verifier from
+// the authorization callback request, according to RFC 5849
+// 2.2.
+func ParseCallback(r *http.Request) (token, verifier string, err error) {
+	query := r.URL.Query()
+	token = query.Get("oauth_token")
+	verifier = query.Get("oauth_verifier")
+	if token == "" || verifier == "" {
+		return "", "", ErrMissingVerifier
+	}
+	return token, verifier, nil
+}
+
+// post sends a signed request to the token endpoint and
+// returns the form encoded response.
+func (c *Config) post(ctx context.Context, endpoint string, extra map[string]string) (url.Values, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &Transport{
+		ConsumerKey: c.ConsumerKey,
+		PrivateKey:  c.PrivateKey,
+		noncer:      c.noncer,
+		clock:       c.clock,
+	}
+	if err := t.setAuthHeader(req, extra); err != nil {
+		return nil, err
+	}
+
+	res, err := c.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	values, _ := url.ParseQuery(string(body))
+	if res.StatusCode > 299 {
+		return nil, &Error{
+			Status:  res.StatusCode,
+			Problem: values.Get("oauth_problem"),
+		}
+	}
+	return values, nil
+}
+
+// client returns the http client. If no client is
+// configured, the default client is returned.
+func (c *Config) client() *http.Client {
+	if c.Client != nil {
+		return c.Client
+	}
+	return http.DefaultClient
+}
+
+// Error is the error returned when the token endpoint
+// returns a non-2XX HTTP status code.
+type Error struct {
+	Status  int
+	Problem string
+}
+
+func (e *Error) Error() string {
+	if e.Problem != "" {
+		return "oauth1: " + e.Problem
+	}
+	return "oauth1: " + http.StatusText(e.Status)
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package oauth1
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+type fixedNoncer string
+
+func (n fixedNoncer) Nonce() string { return string(n) }
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+// verifyRequest verifies the request signature and returns
+// the oauth protocol parameters.
+func verifyRequest(t *testing.T, server string, r *http.Request) map[string]string {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Errorf("Expect OAuth authorization header, got %q", header)
+		return nil
+	}
+	params := map[string]string{}
+	for _, pair := range strings.Split(strings.TrimPrefix(header, "OAuth "), ", ") {
+		parts := strings.SplitN(pair, "=", 2)
+		key, _ := url.PathUnescape(parts[0])
+		value, _ := url.PathUnescape(strings.Trim(parts[1], `"`))
+		params[key] = value
+	}
+	signature, _ := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	delete(params, "oauth_signature")
+
+	u, _ := url.Parse(server + r.URL.RequestURI())
+	r2 := &http.Request{Method: r.Method, URL: u}
+	digest := sha1.Sum([]byte(signatureBase(r2, collectParameters(r2, params))))
+	if err := rsa.VerifyPKCS1v15(&testKey.PublicKey, crypto.SHA1, digest[:], signature); err != nil {
+		t.Errorf("Expect valid signature, got %v", err)
+	}
+	return params
+}
+
+func TestHandshake(t *testing.T) {
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		params := verifyRequest(t, server.URL, r)
+		if got, want := params["oauth_callback"], "http://localhost:8080/callback"; got != want {
+			t.Errorf("Want oauth_callback %q, got %q", want, got)
+		}
+		if got, want := params["oauth_consumer_key"], "drone"; got != want {
+			t.Errorf("Want oauth_consumer_key %q, got %q", want, got)
+		}
+		if got, want := params["oauth_signature_method"], "RSA-SHA1"; got != want {
+			t.Errorf("Want oauth_signature_method %q, got %q", want, got)
+		}
+		fmt.Fprint(w, "oauth_token=GTSlvbVd7aX4fCVOw8lL&oauth_token_secret=0BnxjLnEUBOjl2yJoaFG&oauth_callback_confirmed=true")
+	})
+	mux.HandleFunc("/plugins/servlet/oauth/access-token", func(w http.ResponseWriter, r *http.Request) {
+		params := verifyRequest(t, server.URL, r)
+		if got, want := params["oauth_token"], "GTSlvbVd7aX4fCVOw8lL"; got != want {
+			t.Errorf("Want oauth_token %q, got %q", want, got)
+		}
+		if got, want := params["oauth_verifier"], "hfdp7dh39dks9884"; got != want {
+			t.Errorf("Want oauth_verifier %q, got %q", want, got)
+		}
+		fmt.Fprint(w, "oauth_token=nnch734d00sl2jdk&oauth_token_secret=pfkkdhi9sl3r4s00&oauth_expires_in=157680000")
+	})
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	c := &Config{
+		ConsumerKey: "drone",
+		PrivateKey:  testKey,
+		CallbackURL: "http://localhost:8080/callback",
+		Endpoint:    BitbucketServer(server.URL + "/"),
+		noncer:      fixedNoncer("kllo9940pd9333jh"),
+		clock:       fixedClock(time.Unix(1191242096, 0)),
+	}
+
+	token, err := c.RequestToken(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := token.Token, "GTSlvbVd7aX4fCVOw8lL"; got != want {
+		t.Errorf("Want request token %q, got %q", want, got)
+	}
+	if got, want := token.Secret, "0BnxjLnEUBOjl2yJoaFG"; got != want {
+		t.Errorf("Want request token secret %q, got %q", want, got)
+	}
+
+	want := server.URL + "/plugins/servlet/oauth/authorize?oauth_token=GTSlvbVd7aX4fCVOw8lL"
+	if got := c.AuthorizeURL(token); got != want {
+		t.Errorf("Want authorize url %q, got %q", want, got)
+	}
+
+	r, _ := http.NewRequest("GET", "/callback?oauth_token=GTSlvbVd7aX4fCVOw8lL&oauth_verifier=hfdp7dh39dks9884", nil)
+	_, verifier, err := ParseCallback(r)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	access, err := c.AccessToken(context.Background(), token, verifier)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := access.Token, "nnch734d00sl2jdk"; got != want {
+		t.Errorf("Want access token %q, got %q", want, got)
+	}
+	if access.Expires.IsZero() {
+		t.Errorf("Expect access token expiry set")
+	}
+}
+
+func TestHandshake_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+		fmt.Fprint(w, "oauth_problem=consumer_key_unknown")
+	}))
+	defer server.Close()
+
+	c := &Config{
+		ConsumerKey: "drone",
+		PrivateKey:  testKey,
+		Endpoint:    BitbucketServer(server.URL),
+	}
+	_, err := c.RequestToken(context.Background())
+	e, ok := err.(*Error)
+	if !ok {
+		t.Errorf("Expect *Error, got %v", err)
+		return
+	}
+	if got, want := e.Problem, "consumer_key_unknown"; got != want {
+		t.Errorf("Want problem %q, got %q", want, got)
+	}
+	if got, want := e.Status, 401; got != want {
+		t.Errorf("Want status %d, got %d", want, got)
+	}
+}
+
+func TestParseCallback_Missing(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/callback?oauth_token=GTSlvbVd7aX4fCVOw8lL", nil)
+	_, _, err := ParseCallback(r)
+	if err != ErrMissingVerifier {
+		t.Errorf("Expect missing verifier error, got %v", err)
+	}
+}
@@ -82,8 +82,20 @@ func (t *Transport) base() http.RoundTripper {
 // authenticated requests with an AccessToken according to
 // RFC 5849 3.1.
 func (t *Transport) setRequestAuthHeader(r *http.Request, token *scm.Token) error {
+	return t.setAuthHeader(r, map[string]string{
+		"oauth_token": token.Token,
+	})
+}
+
+// setAuthHeader sets the OAuth1 header, including the
+// additional protocol parameters, according to RFC 5849
+// 3.1. The additional parameters are used to provide the
+// token, callback and verifier.
+func (t *Transport) setAuthHeader(r *http.Request, extra map[string]string) error {
 	oauthParams := t.commonOAuthParams()
-	oauthParams["oauth_token"] = token.Token
+	for key, value := range extra {
+		oauthParams[key] = value
+	}
 	params := collectParameters(r, oauthParams)
 
 	signatureBase := signatureBase(r, params)
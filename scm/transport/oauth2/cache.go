@@ -0,0 +1,219 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// CachingRefresher is a TokenSource that caches the token
+// returned by its Source and refreshes the cached token when
+// it expires. Concurrent refreshes of an expired token are
+// collapsed into a single request to the token endpoint.
+//
+// Unlike the Refresher, the token returned by the Source is
+// never modified in place; a refreshed token is a copy. The
+// returned tokens are also copies, so callers cannot modify
+// the cached token. The CachingRefresher is safe for
+// concurrent use by multiple goroutines.
+type CachingRefresher struct {
+	ClientID     string
+	ClientSecret string
+	Endpoint     string
+
+	// Source provides the initial token, for example a token
+	// loaded from the database. It is only consulted until a
+	// token is cached.
+	Source scm.TokenSource
+	Client *http.Client
+
+	// Save is invoked with the refreshed token so that it can
+	// be persisted. This is required for providers that rotate
+	// refresh tokens, where the previous refresh token is
+	// invalidated once it is used. If Save returns an error,
+	// the error is returned to the caller, but the refreshed
+	// token is still cached.
+	Save func(context.Context, *scm.Token) error
+
+	// Timeout limits the duration of a token refresh, which
+	// is not canceled when the callers give up waiting. If
+	// zero, the DefaultRefreshTimeout is used.
+	Timeout time.Duration
+
+	mu    sync.Mutex
+	token *scm.Token
+	call  *refreshCall
+}
+
+// DefaultRefreshTimeout is the default duration after which
+// a token refresh is canceled.
+const DefaultRefreshTimeout = 30 * time.Second
+
+// refreshCall represents an in-flight token refresh.
+type refreshCall struct {
+	done  chan struct{}
+	token *scm.Token
+	err   error
+}
+
+// Token returns the cached token. If the token is missing or
+// expired, the token is refreshed.
+func (t *CachingRefresher) Token(ctx context.Context) (*scm.Token, error) {
+	token, err := t.load(ctx)
+	if err != nil || token == nil {
+		return copyToken(token), err
+	}
+	if !expired(token) {
+		return copyToken(token), nil
+	}
+	token, err = t.wait(ctx, token, false)
+	return copyToken(token), err
+}
+
+// Refresh refreshes the cached token, regardless of whether
+// or not it is expired. This can be used to recover when the
+// provider revokes the access token before its expiry.
+func (t *CachingRefresher) Refresh(ctx context.Context) (*scm.Token, error) {
+	token, err := t.load(ctx)
+	if err != nil || token == nil {
+		return copyToken(token), err
+	}
+	token, err = t.wait(ctx, token, true)
+	return copyToken(token), err
+}
+
+// load returns the cached token, populating the cache from
+// the Source if empty. The cached token is shared, and must
+// be copied before it is returned to the caller.
+func (t *CachingRefresher) load(ctx context.Context) (*scm.Token, error) {
+	t.mu.Lock()
+	token := t.token
+	t.mu.Unlock()
+	if token != nil {
+		return token, nil
+	}
+
+	token, err := t.Source.Token(ctx)
+	if err != nil || token == nil {
+		return token, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token == nil {
+		copied := *token
+		t.token = &copied
+	}
+	return t.token, nil
+}
+
+// wait refreshes the cached token and waits for the result.
+// If a refresh is already in progress, the result of the
+// in-flight refresh is returned instead. The current token
+// is the token read by the caller; if it has since been
+// replaced by a completed refresh, or is no longer expired
+// and the refresh is not forced, the cached token is returned
+// without refreshing again. Refreshing with a replaced token
+// would waste a grant, and fail if the refresh token was
+// rotated.
+func (t *CachingRefresher) wait(ctx context.Context, current *scm.Token, force bool) (*scm.Token, error) {
+	t.mu.Lock()
+	call := t.call
+	if call == nil && (t.token != current || (!force && !expired(t.token))) {
+		token := t.token
+		t.mu.Unlock()
+		return token, nil
+	}
+	if call == nil {
+		call = &refreshCall{done: make(chan struct{})}
+		t.call = call
+		go t.refresh(ctx, call, t.token)
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// refresh exchanges the refresh token for a new access token,
+// updates the cache and completes the in-flight call.
+func (t *CachingRefresher) refresh(ctx context.Context, call *refreshCall, current *scm.Token) {
+	// the refresh is detached from the cancellation of the
+	// first caller so that other callers waiting on the
+	// refresh are not affected. The refresh is bounded by the
+	// timeout instead, so that a token endpoint that does not
+	// respond cannot block the callers indefinitely.
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, t.timeout())
+	defer cancel()
+
+	var token *scm.Token
+	out, err := grant(ctx, t.client(), t.ClientID, t.ClientSecret, t.Endpoint, current.Refresh)
+	if err == nil {
+		copied := *current
+		out.apply(&copied)
+		token = &copied
+		if t.Save != nil {
+			err = t.Save(ctx, copyToken(token))
+		}
+	}
+
+	t.mu.Lock()
+	if token != nil {
+		t.token = token
+	}
+	t.call = nil
+	t.mu.Unlock()
+
+	call.token, call.err = token, err
+	close(call.done)
+}
+
+// timeout returns the refresh timeout. If no timeout is
+// configured, the default timeout is returned.
+func (t *CachingRefresher) timeout() time.Duration {
+	if t.Timeout > 0 {
+		return t.Timeout
+	}
+	return DefaultRefreshTimeout
+}
+
+// copyToken returns a copy of the token, so that the cached
+// token cannot be modified by the caller.
+func copyToken(token *scm.Token) *scm.Token {
+	if token == nil {
+		return nil
+	}
+	copied := *token
+	return &copied
+}
+
+// client returns the http transport. If no base client
+// is configured, the default client is returned.
+func (t *CachingRefresher) client() *http.Client {
+	if t.Client != nil {
+		return t.Client
+	}
+	return http.DefaultClient
+}
+
+// detachedContext is a context that retains the values of
+// its parent, but is never canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
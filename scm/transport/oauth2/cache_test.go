@@ -0,0 +1,314 @@
+// Copyright 2026 Drone.IO Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package oauth2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+)
+
+func TestCachingRefresher(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		if got, want := r.FormValue("refresh_token"), "3a2bfce4cb9b0f"; got != want {
+			t.Errorf("Want refresh token %q, got %q", want, got)
+		}
+		// delay the response so that concurrent callers
+		// queue up behind the in-flight refresh.
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprintf(w, `{"access_token":"9698fa6a8113b3-%d","expires_in":7200,"refresh_token":"a1b2c3d4e5f6"}`, n)
+	}))
+	defer server.Close()
+
+	before := &scm.Token{
+		Token:   "6084984dab20e6",
+		Refresh: "3a2bfce4cb9b0f",
+		Expires: time.Now().Add(-time.Hour),
+	}
+
+	var saved []*scm.Token
+	r := &CachingRefresher{
+		ClientID:     "dafe3804960dab",
+		ClientSecret: "20e651849b1f12",
+		Endpoint:     server.URL,
+		Source:       StaticTokenSource(before),
+		Save: func(ctx context.Context, token *scm.Token) error {
+			saved = append(saved, token)
+			return nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	tokens := make([]*scm.Token, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			token, err := r.Token(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			tokens[i] = token
+		}(i)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expect a single refresh request, got %d", got)
+	}
+	for _, token := range tokens {
+		if token == nil || token.Token != "9698fa6a8113b3-1" {
+			t.Errorf("Expect refreshed access token, got %v", token)
+		}
+	}
+	if got, want := tokens[0].Refresh, "a1b2c3d4e5f6"; got != want {
+		t.Errorf("Expect rotated refresh token %q, got %q", want, got)
+	}
+	if len(saved) != 1 || *saved[0] != *tokens[0] {
+		t.Errorf("Expect refreshed token saved once, got %d", len(saved))
+	}
+	if before.Token != "6084984dab20e6" || before.Refresh != "3a2bfce4cb9b0f" {
+		t.Errorf("Expect source token not modified")
+	}
+
+	// subsequent requests are served from the cache.
+	token, _ := r.Token(context.Background())
+	if *token != *tokens[0] {
+		t.Errorf("Expect cached token")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expect no additional refresh requests, got %d", got)
+	}
+}
+
+func TestCachingRefresher_RetainRefreshToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3","expires_in":7200}`)
+	}))
+	defer server.Close()
+
+	r := &CachingRefresher{
+		Endpoint: server.URL,
+		Source: StaticTokenSource(&scm.Token{
+			Refresh: "3a2bfce4cb9b0f",
+		}),
+	}
+
+	token, err := r.Token(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := token.Token, "9698fa6a8113b3"; got != want {
+		t.Errorf("Want access token %q, got %q", want, got)
+	}
+	if got, want := token.Refresh, "3a2bfce4cb9b0f"; got != want {
+		t.Errorf("Expect refresh token retained, got %q", got)
+	}
+}
+
+func TestCachingRefresher_Refresh(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3","expires_in":7200}`)
+	}))
+	defer server.Close()
+
+	r := &CachingRefresher{
+		Endpoint: server.URL,
+		Source: StaticTokenSource(&scm.Token{
+			Token:   "6084984dab20e6",
+			Refresh: "3a2bfce4cb9b0f",
+			Expires: time.Now().Add(time.Hour),
+		}),
+	}
+
+	token, _ := r.Token(context.Background())
+	if got, want := token.Token, "6084984dab20e6"; got != want {
+		t.Errorf("Expect token not refreshed, got %q", got)
+	}
+
+	token, err := r.Refresh(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := token.Token, "9698fa6a8113b3"; got != want {
+		t.Errorf("Expect token refreshed, got %q", got)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("Expect a single refresh request, got %d", got)
+	}
+}
+
+func TestCachingRefresher_Replaced(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3","expires_in":7200}`)
+	}))
+	defer server.Close()
+
+	expired := &scm.Token{
+		Token:   "6084984dab20e6",
+		Refresh: "3a2bfce4cb9b0f",
+		Expires: time.Now().Add(-time.Hour),
+	}
+	refreshed := &scm.Token{
+		Token:   "9698fa6a8113b3",
+		Refresh: "a1b2c3d4e5f6",
+		Expires: time.Now().Add(time.Hour),
+	}
+	r := &CachingRefresher{
+		Endpoint: server.URL,
+		token:    refreshed,
+	}
+
+	// the caller read the expired token before an in-flight
+	// refresh completed and replaced it, so the refreshed
+	// token is returned instead of refreshing again.
+	for _, force := range []bool{false, true} {
+		token, err := r.wait(context.Background(), expired, force)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if token != refreshed {
+			t.Errorf("Expect refreshed token, got %v", token)
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("Expect no refresh request, got %d", got)
+	}
+}
+
+func TestCachingRefresher_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Invalid refresh token"}`)
+	}))
+	defer server.Close()
+
+	before := &scm.Token{
+		Refresh: "3a2bfce4cb9b0f",
+	}
+	r := &CachingRefresher{
+		Endpoint: server.URL,
+		Source:   StaticTokenSource(before),
+	}
+
+	_, err := r.Token(context.Background())
+	if err == nil {
+		t.Errorf("Expect refresh error")
+		return
+	}
+	if got, want := err.Error(), "Invalid refresh token"; got != want {
+		t.Errorf("Want error %q, got %q", want, got)
+	}
+}
+
+func TestCachingRefresher_SaveError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3","expires_in":7200}`)
+	}))
+	defer server.Close()
+
+	want := errors.New("database is locked")
+	r := &CachingRefresher{
+		Endpoint: server.URL,
+		Source: StaticTokenSource(&scm.Token{
+			Refresh: "3a2bfce4cb9b0f",
+		}),
+		Save: func(context.Context, *scm.Token) error {
+			return want
+		},
+	}
+
+	if _, err := r.Token(context.Background()); err != want {
+		t.Errorf("Expect save error, got %v", err)
+	}
+
+	// the refreshed token is cached even if it could not
+	// be persisted, since the provider may have revoked the
+	// previous refresh token.
+	token, err := r.Token(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := token.Token, "9698fa6a8113b3"; got != want {
+		t.Errorf("Expect refreshed token cached, got %q", got)
+	}
+}
+
+func TestCachingRefresher_Copy(t *testing.T) {
+	r := &CachingRefresher{
+		Source: StaticTokenSource(&scm.Token{
+			Token:   "6084984dab20e6",
+			Expires: time.Now().Add(time.Hour),
+		}),
+	}
+
+	token, _ := r.Token(context.Background())
+	token.Token = "a1b2c3d4e5f6"
+
+	token, _ = r.Token(context.Background())
+	if got, want := token.Token, "6084984dab20e6"; got != want {
+		t.Errorf("Expect cached token not modified, got %q", got)
+	}
+}
+
+func TestCachingRefresher_Timeout(t *testing.T) {
+	var calls int32
+	hang := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first refresh does not respond until the
+		// request is canceled.
+		if atomic.AddInt32(&calls, 1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-hang:
+			}
+			return
+		}
+		fmt.Fprint(w, `{"access_token":"9698fa6a8113b3","expires_in":7200}`)
+	}))
+	defer server.Close()
+	defer close(hang)
+
+	r := &CachingRefresher{
+		Endpoint: server.URL,
+		Timeout:  50 * time.Millisecond,
+		Source: StaticTokenSource(&scm.Token{
+			Refresh: "3a2bfce4cb9b0f",
+		}),
+	}
+
+	if _, err := r.Token(context.Background()); err == nil {
+		t.Errorf("Expect refresh timeout error")
+	}
+
+	// the timed out refresh is cleared, so the next caller
+	// starts a new refresh.
+	token, err := r.Token(context.Background())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if got, want := token.Token, "9698fa6a8113b3"; got != want {
+		t.Errorf("Want access token %q, got %q", want, got)
+	}
+}
@@ -25,7 +25,8 @@ const expiryDelta = time.Minute
 // token if expired.
 //
 // IMPORTANT the Refresher is NOT safe for concurrent use
-// by multiple goroutines.
+// by multiple goroutines. Use the CachingRefresher when the
+// token is shared across goroutines.
 type Refresher struct {
 	ClientID     string
 	ClientSecret string
@@ -54,23 +55,43 @@ func (t *Refresher) Token(ctx context.Context) (*scm.Token, error) {
 
 // Refresh refreshes the expired token.
 func (t *Refresher) Refresh(token *scm.Token) error {
+	out, err := grant(context.Background(), t.client(), t.ClientID, t.ClientSecret, t.Endpoint, token.Refresh)
+	if err != nil {
+		return err
+	}
+	out.apply(token)
+	return nil
+}
+
+// client returns the http transport. If no base client
+// is configured, the default client is returned.
+func (t *Refresher) client() *http.Client {
+	if t.Client != nil {
+		return t.Client
+	}
+	return http.DefaultClient
+}
+
+// grant exchanges the refresh token for a new access token
+// at the token endpoint.
+func grant(ctx context.Context, client *http.Client, clientID, clientSecret, endpoint, refresh string) (*tokenGrant, error) {
 	values := url.Values{}
 	values.Set("grant_type", "refresh_token")
-	values.Set("refresh_token", token.Refresh)
+	values.Set("refresh_token", refresh)
 
 	reader := strings.NewReader(
 		values.Encode(),
 	)
-	req, err := http.NewRequest("POST", t.Endpoint, reader)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, reader)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	req.SetBasicAuth(t.ClientID, t.ClientSecret)
+	req.SetBasicAuth(clientID, clientSecret)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	res, err := t.client().Do(req)
+	res, err := client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer res.Body.Close()
 
@@ -78,32 +99,17 @@ func (t *Refresher) Refresh(token *scm.Token) error {
 		out := new(tokenError)
 		err = json.NewDecoder(res.Body).Decode(out)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		return out
+		return nil, out
 	}
 
 	out := new(tokenGrant)
 	err = json.NewDecoder(res.Body).Decode(out)
 	if err != nil {
-		return err
-	}
-
-	token.Token = out.Access
-	token.Refresh = out.Refresh
-	token.Expires = time.Now().Add(
-		time.Duration(out.Expires) * time.Second,
-	)
-	return nil
-}
-
-// client returns the http transport. If no base client
-// is configured, the default client is returned.
-func (t *Refresher) client() *http.Client {
-	if t.Client != nil {
-		return t.Client
+		return nil, err
 	}
-	return http.DefaultClient
+	return out, nil
 }
 
 // expired reports whether the token is expired.
@@ -125,6 +131,20 @@ type tokenGrant struct {
 	Expires int64  `json:"expires_in"`
 }
 
+// apply updates the token with the granted access token.
+// Providers that rotate refresh tokens (e.g. Bitbucket and
+// GitLab) return a new refresh token with every grant; the
+// existing refresh token is retained if none is returned.
+func (g *tokenGrant) apply(token *scm.Token) {
+	token.Token = g.Access
+	if g.Refresh != "" {
+		token.Refresh = g.Refresh
+	}
+	token.Expires = time.Now().Add(
+		time.Duration(g.Expires) * time.Second,
+	)
+}
+
 // tokenError is the error returned when the token endpoint
 // returns a non-2XX HTTP status code.
 type tokenError struct {
@@ -36,6 +36,10 @@ type (
 		// FindEmail returns the authenticated user email.
 		FindEmail(context.Context) (string, *Response, error)
 
+		// FindTokenInfo returns the metadata, including the
+		// granted scopes, of the token used to authenticate.
+		FindTokenInfo(context.Context) (*TokenInfo, *Response, error)
+
 		// FindLogin returns the user account by username.
 		FindLogin(context.Context, string) (*User, *Response, error)
 
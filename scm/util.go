@@ -140,9 +140,9 @@ func ConvertExecutionStatus(from string) ExecutionStatus {
 		return StatusRunning
 	case "success", "completed", "SUCCESSFUL":
 		return StatusSuccess
-	case "Failed", "failure", "FAILED":
+	case "Failed", "failed", "failure", "FAILED":
 		return StatusFailed
-	case "Canceled", "cancelled", "STOPPED":
+	case "Canceled", "canceled", "cancelled", "STOPPED":
 		return StatusCanceled
 	case "pending", "queued":
 		return StatusPending
@@ -5,6 +5,7 @@
 package scm
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"time"
@@ -137,6 +138,19 @@ type (
 		Sender      User
 	}
 
+	// PullRequestReviewHook represents a pull request
+	// reviewer status change. It is only emitted by the
+	// bitbucket server driver, for the pr:reviewer events.
+	// State is the review status set by the Reviewer.
+	PullRequestReviewHook struct {
+		Action      Action
+		Repo        Repository
+		PullRequest PullRequest
+		Reviewer    User
+		State       ReviewState
+		Sender      User
+	}
+
 	// ReviewCommentHook represents a pull request review
 	// comment, eg pull_request_review_comment.
 	ReviewCommentHook struct {
@@ -144,6 +158,7 @@ type (
 		Repo        Repository
 		PullRequest PullRequest
 		Review      Review
+		Sender      User
 	}
 
 	// DeployHook represents a deployment event. This is
@@ -169,6 +184,20 @@ type (
 		Sender  User
 	}
 
+	// RawHook represents a provider event that is not
+	// modelled by one of the hook types above, eg gitlab
+	// feature flag and wiki page events, or any unknown
+	// event when enabled with WithRawHooks. Event holds
+	// the native event name from the event header, Action
+	// the native action, and Data the raw payload.
+	RawHook struct {
+		Event  string
+		Action string
+		Repo   Repository
+		Sender User
+		Data   []byte
+	}
+
 	// SecretFunc provides the Webhook parser with the
 	// secret key used to validate webhook authenticity.
 	SecretFunc func(webhook Webhook) (string, error)
@@ -181,6 +210,25 @@ type (
 	}
 )
 
+// rawHooksKey is the context key used to enable raw hooks.
+type rawHooksKey struct{}
+
+// WithRawHooks returns a copy of parent that instructs the
+// WebhookService to return a RawHook, instead of
+// ErrUnknownEvent, for events the driver does not recognize.
+// The payload signature is verified as usual. It is set on
+// the context of the webhook http.Request.
+func WithRawHooks(parent context.Context) context.Context {
+	return context.WithValue(parent, rawHooksKey{}, true)
+}
+
+// RawHooksEnabled returns true if raw hooks were enabled for
+// the context with WithRawHooks.
+func RawHooksEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(rawHooksKey{}).(bool)
+	return enabled
+}
+
 // Repository() defines the repository webhook and provides
 // a convenient way to get the associated repository without
 // having to cast the type.
@@ -194,7 +242,9 @@ func (h *IssueHook) Repository() Repository              { return h.Repo }
 func (h *IssueCommentHook) Repository() Repository       { return h.Repo }
 func (h *PullRequestHook) Repository() Repository        { return h.Repo }
 func (h *PullRequestCommentHook) Repository() Repository { return h.Repo }
+func (h *PullRequestReviewHook) Repository() Repository  { return h.Repo }
 func (h *ReviewCommentHook) Repository() Repository      { return h.Repo }
 func (h *ReleaseHook) Repository() Repository            { return h.Repo }
 func (h *PipelineHook) Repository() Repository           { return h.Repo }
 func (h *CheckHook) Repository() Repository              { return h.Repo }
+func (h *RawHook) Repository() Repository                { return h.Repo }